@@ -2,16 +2,21 @@ package cmd
 
 import (
 	"os"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
 	"github.com/geropl/github-mcp-go/pkg/github"
+	"github.com/geropl/github-mcp-go/pkg/redact"
 	"github.com/geropl/github-mcp-go/pkg/tools"
 )
 
 var (
-	verbose bool
+	verbose      bool
+	proxyURL     string
+	caBundlePath string
+	timeout      time.Duration
 )
 
 // serveCmd represents the serve command
@@ -27,18 +32,27 @@ This command starts the GitHub MCP server, which provides tools for interacting
 		if verbose {
 			logger.SetLevel(logrus.DebugLevel)
 		}
-		logger.SetFormatter(&logrus.TextFormatter{
+		// All log output goes through the redacting formatter so tokens and signed URLs are masked
+		logger.SetFormatter(redact.NewFormatter(&logrus.TextFormatter{
 			FullTimestamp: true,
-		})
+		}))
 
 		// Check for GitHub token
 		token := os.Getenv("GITHUB_PERSONAL_ACCESS_TOKEN")
 		if token == "" {
 			logger.Fatal("GITHUB_PERSONAL_ACCESS_TOKEN environment variable is required")
 		}
+		redact.AddSecret(token)
 
 		// Create GitHub client
-		githubClient := github.NewClient(token, logger)
+		githubClient, err := github.NewClientWithOptions(token, github.ClientOptions{
+			ProxyURL:     proxyURL,
+			CABundlePath: caBundlePath,
+			Timeout:      timeout,
+		}, logger)
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to configure GitHub client")
+		}
 
 		// Create MCP server
 		serverName := "github-mcp-server"
@@ -70,4 +84,7 @@ func init() {
 	// Add flags to the serve command
 	serveCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose logging")
 	serveCmd.Flags().BoolVar(&writeAccess, "write-access", false, "Enable write access for remote operations")
+	serveCmd.Flags().StringVar(&proxyURL, "proxy", "", "HTTP(S) proxy URL for outbound requests (default: HTTPS_PROXY/HTTP_PROXY environment variables)")
+	serveCmd.Flags().StringVar(&caBundlePath, "ca-bundle", "", "Path to a PEM file with additional CA certificates to trust")
+	serveCmd.Flags().DurationVar(&timeout, "timeout", 0, "Timeout for each outbound HTTP request, e.g. 30s (default: no timeout)")
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/geropl/github-mcp-go/pkg/redact"
 	"github.com/geropl/github-mcp-go/pkg/setup"
 	"github.com/geropl/github-mcp-go/pkg/tools"
 	"github.com/spf13/cobra"
@@ -38,6 +39,7 @@ The --tool flag specifies which AI assistant tool(s) to set up for. It takes a c
 			fmt.Println("GITHUB_PERSONAL_ACCESS_TOKEN environment variable is required")
 			os.Exit(1)
 		}
+		redact.AddSecret(token)
 
 		// Set up the tool-specific configuration
 		options := setup.SetupOptions{
@@ -54,7 +56,7 @@ The --tool flag specifies which AI assistant tool(s) to set up for. It takes a c
 			// Print a summary of errors
 			fmt.Println("\nSetup completed with errors:")
 			for _, err := range errors {
-				fmt.Printf("- %v\n", err)
+				fmt.Printf("- %s\n", redact.String(err.Error()))
 			}
 			os.Exit(1)
 		}
@@ -63,7 +63,7 @@ func TestSetupCommand(t *testing.T) {
 							"mcpServers": {
 								"github": {
 									"args": ["serve", "--write-access=true"],
-									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_issue", "list_issues", "list_issue_comments", "get_pull_request", "get_pull_request_diff", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
+									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_repository_tree", "get_issue", "list_issues", "list_issue_comments", "get_issue_timeline", "get_issue_hierarchy", "list_closing_pull_requests", "list_issue_templates", "list_reactions", "list_labels", "list_milestones", "get_milestone", "get_pull_request", "list_pull_requests", "get_pull_request_reviews", "list_review_threads", "list_requested_reviewers", "get_pull_request_diff", "get_pull_request_files", "get_pull_request_status", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
 									"disabled": false
 								}
 							}
@@ -87,7 +87,7 @@ func TestSetupCommand(t *testing.T) {
 							"mcpServers": {
 								"github": {
 									"args": ["serve", "--write-access=true"],
-									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_issue", "list_issues", "list_issue_comments", "get_pull_request", "get_pull_request_diff", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
+									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_repository_tree", "get_issue", "list_issues", "list_issue_comments", "get_issue_timeline", "get_issue_hierarchy", "list_closing_pull_requests", "list_issue_templates", "list_reactions", "list_labels", "list_milestones", "get_milestone", "get_pull_request", "list_pull_requests", "get_pull_request_reviews", "list_review_threads", "list_requested_reviewers", "get_pull_request_diff", "get_pull_request_files", "get_pull_request_status", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
 									"disabled": false
 								}
 							}
@@ -111,7 +111,7 @@ func TestSetupCommand(t *testing.T) {
 							"mcpServers": {
 								"github": {
 									"args": ["serve", "--write-access=true"],
-									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_issue", "list_issues", "list_issue_comments", "get_pull_request", "get_pull_request_diff", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
+									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_repository_tree", "get_issue", "list_issues", "list_issue_comments", "get_issue_timeline", "get_issue_hierarchy", "list_closing_pull_requests", "list_issue_templates", "list_reactions", "list_labels", "list_milestones", "get_milestone", "get_pull_request", "list_pull_requests", "get_pull_request_reviews", "list_review_threads", "list_requested_reviewers", "get_pull_request_diff", "get_pull_request_files", "get_pull_request_status", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
 									"disabled": false
 								}
 							}
@@ -135,7 +135,7 @@ func TestSetupCommand(t *testing.T) {
 							"mcpServers": {
 								"github": {
 									"args": ["serve", "--write-access=true"],
-									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_issue", "list_issues", "list_issue_comments", "get_pull_request", "get_pull_request_diff", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
+									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_repository_tree", "get_issue", "list_issues", "list_issue_comments", "get_issue_timeline", "get_issue_hierarchy", "list_closing_pull_requests", "list_issue_templates", "list_reactions", "list_labels", "list_milestones", "get_milestone", "get_pull_request", "list_pull_requests", "get_pull_request_reviews", "list_review_threads", "list_requested_reviewers", "get_pull_request_diff", "get_pull_request_files", "get_pull_request_status", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
 									"disabled": false
 								}
 							}
@@ -148,7 +148,7 @@ func TestSetupCommand(t *testing.T) {
 							"mcpServers": {
 								"github": {
 									"args": ["serve", "--write-access=true"],
-									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_issue", "list_issues", "list_issue_comments", "get_pull_request", "get_pull_request_diff", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
+									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_repository_tree", "get_issue", "list_issues", "list_issue_comments", "get_issue_timeline", "get_issue_hierarchy", "list_closing_pull_requests", "list_issue_templates", "list_reactions", "list_labels", "list_milestones", "get_milestone", "get_pull_request", "list_pull_requests", "get_pull_request_reviews", "list_review_threads", "list_requested_reviewers", "get_pull_request_diff", "get_pull_request_files", "get_pull_request_status", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
 									"disabled": false
 								}
 							}
@@ -172,7 +172,7 @@ func TestSetupCommand(t *testing.T) {
 							"mcpServers": {
 								"github": {
 									"args": ["serve", "--write-access=true"],
-									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_issue", "list_issues", "list_issue_comments", "get_pull_request", "get_pull_request_diff", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
+									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_repository_tree", "get_issue", "list_issues", "list_issue_comments", "get_issue_timeline", "get_issue_hierarchy", "list_closing_pull_requests", "list_issue_templates", "list_reactions", "list_labels", "list_milestones", "get_milestone", "get_pull_request", "list_pull_requests", "get_pull_request_reviews", "list_review_threads", "list_requested_reviewers", "get_pull_request_diff", "get_pull_request_files", "get_pull_request_status", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
 									"disabled": false
 								}
 							}
@@ -185,7 +185,7 @@ func TestSetupCommand(t *testing.T) {
 							"mcpServers": {
 								"github": {
 									"args": ["serve", "--write-access=true"],
-									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_issue", "list_issues", "list_issue_comments", "get_pull_request", "get_pull_request_diff", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
+									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_repository_tree", "get_issue", "list_issues", "list_issue_comments", "get_issue_timeline", "get_issue_hierarchy", "list_closing_pull_requests", "list_issue_templates", "list_reactions", "list_labels", "list_milestones", "get_milestone", "get_pull_request", "list_pull_requests", "get_pull_request_reviews", "list_review_threads", "list_requested_reviewers", "get_pull_request_diff", "get_pull_request_files", "get_pull_request_status", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
 									"disabled": false
 								}
 							}
@@ -209,7 +209,7 @@ func TestSetupCommand(t *testing.T) {
 							"mcpServers": {
 								"github": {
 									"args": ["serve", "--write-access=true"],
-									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_issue", "list_issues", "list_issue_comments", "get_pull_request", "get_pull_request_diff", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
+									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_repository_tree", "get_issue", "list_issues", "list_issue_comments", "get_issue_timeline", "get_issue_hierarchy", "list_closing_pull_requests", "list_issue_templates", "list_reactions", "list_labels", "list_milestones", "get_milestone", "get_pull_request", "list_pull_requests", "get_pull_request_reviews", "list_review_threads", "list_requested_reviewers", "get_pull_request_diff", "get_pull_request_files", "get_pull_request_status", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
 									"disabled": false
 								}
 							}
@@ -222,7 +222,7 @@ func TestSetupCommand(t *testing.T) {
 							"mcpServers": {
 								"github": {
 									"args": ["serve", "--write-access=true"],
-									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_issue", "list_issues", "list_issue_comments", "get_pull_request", "get_pull_request_diff", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
+									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_repository_tree", "get_issue", "list_issues", "list_issue_comments", "get_issue_timeline", "get_issue_hierarchy", "list_closing_pull_requests", "list_issue_templates", "list_reactions", "list_labels", "list_milestones", "get_milestone", "get_pull_request", "list_pull_requests", "get_pull_request_reviews", "list_review_threads", "list_requested_reviewers", "get_pull_request_diff", "get_pull_request_files", "get_pull_request_status", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
 									"disabled": false
 								}
 							}
@@ -235,7 +235,7 @@ func TestSetupCommand(t *testing.T) {
 							"mcpServers": {
 								"github": {
 									"args": ["serve", "--write-access=true"],
-									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_issue", "list_issues", "list_issue_comments", "get_pull_request", "get_pull_request_diff", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
+									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_repository_tree", "get_issue", "list_issues", "list_issue_comments", "get_issue_timeline", "get_issue_hierarchy", "list_closing_pull_requests", "list_issue_templates", "list_reactions", "list_labels", "list_milestones", "get_milestone", "get_pull_request", "list_pull_requests", "get_pull_request_reviews", "list_review_threads", "list_requested_reviewers", "get_pull_request_diff", "get_pull_request_files", "get_pull_request_status", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
 									"disabled": false
 								}
 							}
@@ -313,7 +313,7 @@ func TestSetupCommand(t *testing.T) {
 							"mcpServers": {
 								"github": {
 									"args": ["serve", "--write-access=true"],
-									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_issue", "list_issues", "list_issue_comments", "get_pull_request", "get_pull_request_diff", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
+									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_repository_tree", "get_issue", "list_issues", "list_issue_comments", "get_issue_timeline", "get_issue_hierarchy", "list_closing_pull_requests", "list_issue_templates", "list_reactions", "list_labels", "list_milestones", "get_milestone", "get_pull_request", "list_pull_requests", "get_pull_request_reviews", "list_review_threads", "list_requested_reviewers", "get_pull_request_diff", "get_pull_request_files", "get_pull_request_status", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
 									"disabled": false
 								},
 								"weather-server": {
@@ -387,7 +387,7 @@ func TestSetupCommand(t *testing.T) {
 							"mcpServers": {
 								"github": {
 									"args": ["serve", "--write-access=true"],
-									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_issue", "list_issues", "list_issue_comments", "get_pull_request", "get_pull_request_diff", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
+									"autoApprove": ["search_repositories", "search_code", "search_issues", "search_commits", "get_file_contents", "get_repository_tree", "get_issue", "list_issues", "list_issue_comments", "get_issue_timeline", "get_issue_hierarchy", "list_closing_pull_requests", "list_issue_templates", "list_reactions", "list_labels", "list_milestones", "get_milestone", "get_pull_request", "list_pull_requests", "get_pull_request_reviews", "list_review_threads", "list_requested_reviewers", "get_pull_request_diff", "get_pull_request_files", "get_pull_request_status", "get_commit", "list_commits", "compare_commits", "get_commit_status", "list_commit_comments", "list_branches", "get_branch", "list_workflows", "get_workflow", "list_workflow_runs", "get_workflow_run", "download_workflow_run_logs", "list_workflow_jobs", "get_workflow_job"],
 									"disabled": false
 								}
 							}
@@ -491,7 +491,7 @@ func verifyFileExpectations(t *testing.T, rootDir string, fileExpects map[string
 		filePath := filepath.Join(rootDir, expect.path)
 
 		// Check if file exists
-		_, err := os.Stat(filePath)
+		info, err := os.Stat(filePath)
 		if os.IsNotExist(err) {
 			if expect.mustExist {
 				t.Errorf("Expected file %s was not created for %s", filePath, tool)
@@ -499,6 +499,11 @@ func verifyFileExpectations(t *testing.T, rootDir string, fileExpects map[string
 			continue
 		}
 
+		// The settings contain the token and must not be readable by others
+		if err == nil && info.Mode().Perm() != 0600 {
+			t.Errorf("File %s for %s has mode %v, want 0600", filePath, tool, info.Mode().Perm())
+		}
+
 		// File exists, verify content if expected
 		if expect.content != "" {
 			actualContent, err := os.ReadFile(filePath)
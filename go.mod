@@ -10,6 +10,7 @@ require (
 	github.com/spf13/cobra v1.9.1
 	golang.org/x/oauth2 v0.28.0
 	gopkg.in/dnaeon/go-vcr.v4 v4.0.2
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -18,5 +19,4 @@ require (
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/spf13/pflag v1.0.6 // indirect
 	golang.org/x/sys v0.0.0-20220715151400-c0bba94af5f8 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -1,7 +1,14 @@
 package errors
 
 import (
+	stderrors "errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/geropl/github-mcp-go/pkg/redact"
 )
 
 // Error types
@@ -15,6 +22,7 @@ const (
 	ErrorTypeInternal        = "internal"
 	ErrorTypeGitHubAPI       = "github_api"
 	ErrorTypeInvalidArgument = "invalid_argument"
+	ErrorTypePrecondition    = "precondition_failed"
 )
 
 // GitHubError represents an error from the GitHub API
@@ -23,11 +31,61 @@ type GitHubError struct {
 	Message    string
 	StatusCode int
 	Response   interface{}
+
+	// DocumentationURL is the documentation link GitHub returned with the error
+	DocumentationURL string
+	// Details holds the field-level problems from GitHub's "errors" array
+	Details []ErrorDetail
+	// RetryAfter is how long to wait before retrying, if GitHub said so
+	RetryAfter time.Duration
+	// ResetAt is when the rate limit resets, if known
+	ResetAt time.Time
+	// Err is the underlying error, if any
+	Err error
+}
+
+// ErrorDetail describes a single field-level problem reported by GitHub
+type ErrorDetail struct {
+	Resource string
+	Field    string
+	Code     string
+	Message  string
 }
 
 // Error implements the error interface
 func (e *GitHubError) Error() string {
-	return fmt.Sprintf("%s: %s", e.Type, e.Message)
+	return redact.String(fmt.Sprintf("%s: %s", e.Type, e.Message))
+}
+
+// Unwrap returns the underlying error
+func (e *GitHubError) Unwrap() error {
+	return e.Err
+}
+
+// String renders the detail as "Resource.field: code (message)"
+func (d ErrorDetail) String() string {
+	var sb strings.Builder
+	if d.Resource != "" {
+		sb.WriteString(d.Resource)
+		if d.Field != "" {
+			sb.WriteString(".")
+		}
+	}
+	sb.WriteString(d.Field)
+	if d.Code != "" {
+		if sb.Len() > 0 {
+			sb.WriteString(": ")
+		}
+		sb.WriteString(d.Code)
+	}
+	if d.Message != "" {
+		if sb.Len() > 0 {
+			sb.WriteString(fmt.Sprintf(" (%s)", d.Message))
+		} else {
+			sb.WriteString(d.Message)
+		}
+	}
+	return sb.String()
 }
 
 // NewValidationError creates a new validation error
@@ -70,6 +128,20 @@ func NewRateLimitError(message string, resetAt string) *GitHubError {
 	}
 }
 
+// NewRateLimitErrorWithReset creates a new rate limit error with structured retry information
+func NewRateLimitErrorWithReset(message string, resetAt time.Time, retryAfter time.Duration) *GitHubError {
+	err := &GitHubError{
+		Type:       ErrorTypeRateLimit,
+		Message:    message,
+		ResetAt:    resetAt,
+		RetryAfter: retryAfter,
+	}
+	if !resetAt.IsZero() {
+		err.Message = fmt.Sprintf("%s (resets at: %s)", message, resetAt.UTC().Format(time.RFC3339))
+	}
+	return err
+}
+
 // NewConflictError creates a new conflict error
 func NewConflictError(message string) *GitHubError {
 	return &GitHubError{
@@ -78,6 +150,15 @@ func NewConflictError(message string) *GitHubError {
 	}
 }
 
+// NewPreconditionError creates a new precondition error, used when the resource is not in a state
+// that allows the operation yet (e.g. required checks or reviews are missing)
+func NewPreconditionError(message string) *GitHubError {
+	return &GitHubError{
+		Type:    ErrorTypePrecondition,
+		Message: message,
+	}
+}
+
 // NewInternalError creates a new internal error
 func NewInternalError(message string) *GitHubError {
 	return &GitHubError{
@@ -106,47 +187,132 @@ func NewInvalidArgumentError(message string) *GitHubError {
 
 // CreateGitHubError creates a GitHubError from a GitHub API error
 func CreateGitHubError(statusCode int, response interface{}) *GitHubError {
-	var message string
+	return CreateGitHubErrorWithHeaders(statusCode, response, nil)
+}
+
+// CreateGitHubErrorWithHeaders creates a GitHubError from a GitHub API error response body and headers.
+// The body provides the message, documentation URL and field-level details; the headers provide
+// Retry-After and rate limit reset information.
+func CreateGitHubErrorWithHeaders(statusCode int, response interface{}, header http.Header) *GitHubError {
+	message := "GitHub API error"
+	var documentationURL string
+	var details []ErrorDetail
 	if resp, ok := response.(map[string]interface{}); ok {
-		if msg, ok := resp["message"].(string); ok {
+		if msg, ok := resp["message"].(string); ok && msg != "" {
 			message = msg
-		} else {
-			message = "GitHub API error"
 		}
-	} else {
-		message = "GitHub API error"
+		if docURL, ok := resp["documentation_url"].(string); ok {
+			documentationURL = docURL
+		}
+		details = parseErrorDetails(resp["errors"])
 	}
 
+	retryAfter, resetAt := parseRetryHeaders(header)
+
+	var err *GitHubError
 	switch statusCode {
 	case 401:
-		return NewAuthenticationError(message)
+		err = NewAuthenticationError(message)
 	case 403:
-		return NewPermissionError(message)
+		if retryAfter > 0 {
+			// Secondary rate limits are reported as 403 with a Retry-After header
+			err = NewRateLimitErrorWithReset(message, resetAt, retryAfter)
+		} else {
+			err = NewPermissionError(message)
+		}
 	case 404:
-		return NewNotFoundError(message)
+		err = NewNotFoundError(message)
 	case 409:
-		return NewConflictError(message)
+		err = NewConflictError(message)
+	case 412:
+		err = NewPreconditionError(message)
 	case 422:
-		return NewValidationError(message)
+		err = NewValidationError(message)
 	case 429:
-		resetAt := "unknown"
-		if resp, ok := response.(map[string]interface{}); ok {
-			if reset, ok := resp["reset_at"].(string); ok {
-				resetAt = reset
+		err = NewRateLimitErrorWithReset(message, resetAt, retryAfter)
+	default:
+		err = NewGitHubAPIError(statusCode, message, response)
+	}
+
+	err.StatusCode = statusCode
+	err.Response = response
+	err.DocumentationURL = documentationURL
+	err.Details = details
+	return err
+}
+
+// parseErrorDetails converts GitHub's "errors" array, whose entries are either objects or plain strings
+func parseErrorDetails(raw interface{}) []ErrorDetail {
+	entries, ok := raw.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var details []ErrorDetail
+	for _, entry := range entries {
+		switch v := entry.(type) {
+		case string:
+			details = append(details, ErrorDetail{Message: v})
+		case map[string]interface{}:
+			detail := ErrorDetail{}
+			detail.Resource, _ = v["resource"].(string)
+			detail.Field, _ = v["field"].(string)
+			detail.Code, _ = v["code"].(string)
+			detail.Message, _ = v["message"].(string)
+			details = append(details, detail)
+		}
+	}
+	return details
+}
+
+// parseRetryHeaders reads the Retry-After and X-RateLimit-Reset headers
+func parseRetryHeaders(header http.Header) (time.Duration, time.Time) {
+	if header == nil {
+		return 0, time.Time{}
+	}
+
+	var retryAfter time.Duration
+	if value := header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil {
+			retryAfter = time.Duration(seconds) * time.Second
+		} else if t, err := http.ParseTime(value); err == nil {
+			retryAfter = time.Until(t)
+		}
+	}
+
+	var resetAt time.Time
+	if header.Get("X-RateLimit-Remaining") == "0" {
+		if value := header.Get("X-RateLimit-Reset"); value != "" {
+			if epoch, err := strconv.ParseInt(value, 10, 64); err == nil {
+				resetAt = time.Unix(epoch, 0)
 			}
 		}
-		return NewRateLimitError(message, resetAt)
-	default:
-		return NewGitHubAPIError(statusCode, message, response)
 	}
+
+	return retryAfter, resetAt
+}
+
+// AsGitHubError finds the first GitHubError in err's chain
+func AsGitHubError(err error) (*GitHubError, bool) {
+	var ghErr *GitHubError
+	if stderrors.As(err, &ghErr) {
+		return ghErr, true
+	}
+	return nil, false
 }
 
 // IsGitHubError checks if an error is a GitHubError
 func IsGitHubError(err error) bool {
-	_, ok := err.(*GitHubError)
+	_, ok := AsGitHubError(err)
 	return ok
 }
 
+// IsType checks if err's chain contains a GitHubError of the given type
+func IsType(err error, errorType string) bool {
+	ghErr, ok := AsGitHubError(err)
+	return ok && ghErr.Type == errorType
+}
+
 // FormatGitHubError formats a GitHubError for MCP response
 func FormatGitHubError(err *GitHubError) string {
 	var message string
@@ -154,9 +320,6 @@ func FormatGitHubError(err *GitHubError) string {
 	switch err.Type {
 	case ErrorTypeValidation:
 		message = fmt.Sprintf("Validation Error: %s", err.Message)
-		if err.Response != nil {
-			message += fmt.Sprintf("\nDetails: %v", err.Response)
-		}
 	case ErrorTypeAuthentication:
 		message = fmt.Sprintf("Authentication Failed: %s", err.Message)
 	case ErrorTypePermission:
@@ -167,9 +330,62 @@ func FormatGitHubError(err *GitHubError) string {
 		message = fmt.Sprintf("Rate Limit Exceeded: %s", err.Message)
 	case ErrorTypeConflict:
 		message = fmt.Sprintf("Conflict: %s", err.Message)
+	case ErrorTypeInvalidArgument:
+		message = fmt.Sprintf("Invalid Argument: %s", err.Message)
+	case ErrorTypePrecondition:
+		message = fmt.Sprintf("Precondition Failed: %s", err.Message)
 	default:
 		message = fmt.Sprintf("GitHub API Error: %s", err.Message)
 	}
 
-	return message
+	if len(err.Details) > 0 {
+		message += "\nDetails:"
+		for _, detail := range err.Details {
+			message += fmt.Sprintf("\n- %s", detail)
+		}
+	}
+
+	if hint := errorHint(err); hint != "" {
+		message += "\nHint: " + hint
+	}
+
+	if err.DocumentationURL != "" {
+		message += "\nDocumentation: " + err.DocumentationURL
+	}
+
+	return redact.String(message)
+}
+
+// errorHint returns guidance on how to resolve an error that came from the GitHub API
+func errorHint(err *GitHubError) string {
+	// Locally generated errors carry no status code and need no further guidance
+	if err.StatusCode == 0 && err.Type != ErrorTypeRateLimit {
+		return ""
+	}
+
+	switch err.Type {
+	case ErrorTypeAuthentication:
+		return "Check that GITHUB_PERSONAL_ACCESS_TOKEN is set, valid and not expired."
+	case ErrorTypePermission:
+		return "The token lacks the permission or scope for this operation, or the resource is protected."
+	case ErrorTypeNotFound:
+		return "Check the owner, repository and identifiers. Private resources also return 404 if the token cannot access them."
+	case ErrorTypeRateLimit:
+		if err.RetryAfter > 0 {
+			return fmt.Sprintf("Retry after %s.", err.RetryAfter.Round(time.Second))
+		}
+		if !err.ResetAt.IsZero() {
+			return fmt.Sprintf("Retry after %s.", err.ResetAt.UTC().Format(time.RFC3339))
+		}
+		return "Wait before retrying."
+	case ErrorTypeConflict:
+		return "The resource changed concurrently or is in a conflicting state. Fetch the latest state and retry."
+	case ErrorTypePrecondition:
+		return "Required status checks, reviews or protection rules are not satisfied yet. Check the status and retry later."
+	case ErrorTypeValidation:
+		if len(err.Details) > 0 {
+			return "Fix the fields listed above and retry."
+		}
+	}
+	return ""
 }
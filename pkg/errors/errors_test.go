@@ -0,0 +1,131 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreateGitHubErrorWithHeaders(t *testing.T) {
+	validationBody := map[string]interface{}{
+		"message":           "Validation Failed",
+		"documentation_url": "https://docs.github.com/rest/issues/issues#create-an-issue",
+		"errors": []interface{}{
+			map[string]interface{}{"resource": "Issue", "field": "title", "code": "missing_field"},
+			"labels must be an array",
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		statusCode     int
+		body           interface{}
+		header         http.Header
+		wantType       string
+		wantDetails    int
+		wantRetryAfter time.Duration
+		wantContains   []string
+	}{
+		{
+			name:        "ValidationDetails",
+			statusCode:  422,
+			body:        validationBody,
+			wantType:    ErrorTypeValidation,
+			wantDetails: 2,
+			wantContains: []string{
+				"Validation Error: Validation Failed",
+				"- Issue.title: missing_field",
+				"- labels must be an array",
+				"Documentation: https://docs.github.com/rest/issues/issues#create-an-issue",
+			},
+		},
+		{
+			name:           "RateLimitRetryAfter",
+			statusCode:     429,
+			body:           map[string]interface{}{"message": "Too Many Requests"},
+			header:         http.Header{"Retry-After": []string{"30"}},
+			wantType:       ErrorTypeRateLimit,
+			wantRetryAfter: 30 * time.Second,
+			wantContains:   []string{"Rate Limit Exceeded: Too Many Requests", "Hint: Retry after 30s."},
+		},
+		{
+			name:           "SecondaryRateLimit",
+			statusCode:     403,
+			body:           map[string]interface{}{"message": "You have exceeded a secondary rate limit"},
+			header:         http.Header{"Retry-After": []string{"60"}},
+			wantType:       ErrorTypeRateLimit,
+			wantRetryAfter: time.Minute,
+			wantContains:   []string{"Hint: Retry after 1m0s."},
+		},
+		{
+			name:         "PermissionDenied",
+			statusCode:   403,
+			body:         map[string]interface{}{"message": "Resource not accessible by personal access token"},
+			wantType:     ErrorTypePermission,
+			wantContains: []string{"Permission Denied: Resource not accessible by personal access token", "Hint: "},
+		},
+		{
+			name:         "PreconditionFailed",
+			statusCode:   412,
+			body:         map[string]interface{}{"message": "Precondition Failed"},
+			wantType:     ErrorTypePrecondition,
+			wantContains: []string{"Precondition Failed: Precondition Failed", "Hint: Required status checks"},
+		},
+		{
+			name:         "NonJSONBody",
+			statusCode:   502,
+			body:         nil,
+			wantType:     ErrorTypeGitHubAPI,
+			wantContains: []string{"GitHub API Error: GitHub API error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CreateGitHubErrorWithHeaders(tc.statusCode, tc.body, tc.header)
+			if err.Type != tc.wantType {
+				t.Errorf("Type = %q, want %q", err.Type, tc.wantType)
+			}
+			if err.StatusCode != tc.statusCode {
+				t.Errorf("StatusCode = %d, want %d", err.StatusCode, tc.statusCode)
+			}
+			if len(err.Details) != tc.wantDetails {
+				t.Errorf("len(Details) = %d, want %d", len(err.Details), tc.wantDetails)
+			}
+			if err.RetryAfter != tc.wantRetryAfter {
+				t.Errorf("RetryAfter = %v, want %v", err.RetryAfter, tc.wantRetryAfter)
+			}
+
+			formatted := FormatGitHubError(err)
+			for _, want := range tc.wantContains {
+				if !strings.Contains(formatted, want) {
+					t.Errorf("FormatGitHubError() = %q, want it to contain %q", formatted, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAsGitHubError(t *testing.T) {
+	cause := stderrors.New("connection reset")
+	ghErr := NewNotFoundError("Not Found")
+	ghErr.Err = cause
+	wrapped := fmt.Errorf("getting issue: %w", ghErr)
+
+	found, ok := AsGitHubError(wrapped)
+	if !ok || found != ghErr {
+		t.Fatalf("AsGitHubError() = %v, %t; want the wrapped GitHubError", found, ok)
+	}
+	if !IsType(wrapped, ErrorTypeNotFound) {
+		t.Error("IsType() = false, want true for a wrapped not found error")
+	}
+	if !stderrors.Is(wrapped, cause) {
+		t.Error("errors.Is() = false, want the cause to be reachable through Unwrap")
+	}
+	if IsGitHubError(cause) {
+		t.Error("IsGitHubError() = true for a plain error")
+	}
+}
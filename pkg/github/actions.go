@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -14,6 +15,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/geropl/github-mcp-go/pkg/errors"
+	"github.com/geropl/github-mcp-go/pkg/redact"
 )
 
 // ActionsOperations handles GitHub Actions-related operations
@@ -457,12 +459,17 @@ func (a *ActionsOperations) DownloadWorkflowRunLogs(ctx context.Context, owner,
 	}
 	
 	// Download the logs from the URL
-	a.logger.Infof("Downloading workflow run logs from %s", logsURL.String())
-	httpClient := a.client.GetClient().Client()
-	resp, err := httpClient.Get(logsURL.String())
+	a.logger.Infof("Downloading workflow run logs from %s", redact.URL(logsURL.String()))
+	// The URL is pre-signed, so the download goes through the plain HTTP client without the API token
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logsURL.String(), nil)
 	if err != nil {
 		os.RemoveAll(logsDir) // Clean up logs directory on error
-		return nil, fmt.Errorf("failed to download logs: %w", err)
+		return nil, fmt.Errorf("failed to create logs download request: %w", err)
+	}
+	resp, err := a.client.GetHTTPClient().Do(req)
+	if err != nil {
+		os.RemoveAll(logsDir) // Clean up logs directory on error
+		return nil, fmt.Errorf("failed to download logs: %s", redact.String(err.Error()))
 	}
 	defer resp.Body.Close()
 	
@@ -552,8 +559,8 @@ func extractZip(zipFilePath, destDir string) (int, []string, error) {
 			return 0, nil, fmt.Errorf("failed to create file: %w", err)
 		}
 		
-		// Copy the contents
-		_, err = io.Copy(outFile, rc)
+		// Copy the contents, masking secrets that leaked into the job output
+		_, err = redact.Copy(outFile, rc)
 		outFile.Close()
 		rc.Close()
 		
@@ -24,7 +24,7 @@ func NewBranchOperations(client *Client, logger *logrus.Logger) *BranchOperation
 }
 
 // ListBranches lists branches in a repository with optional filtering
-func (b *BranchOperations) ListBranches(ctx context.Context, owner, repo string, protected bool) ([]*github.Branch, error) {
+func (b *BranchOperations) ListBranches(ctx context.Context, owner, repo string, protected bool, pagination PaginationOptions) (*ListResult[*github.Branch], error) {
 	// Validate parameters
 	if owner == "" {
 		return nil, errors.NewValidationError("owner cannot be empty")
@@ -36,18 +36,18 @@ func (b *BranchOperations) ListBranches(ctx context.Context, owner, repo string,
 	// Set up options
 	opts := &github.BranchListOptions{
 		Protected: &protected,
-		ListOptions: github.ListOptions{
-			PerPage: 100,
-		},
 	}
 
 	// List branches
-	branches, _, err := b.client.GetClient().Repositories.ListBranches(ctx, owner, repo, opts)
+	result, err := Paginate(ctx, pagination, 100, func(listOpts github.ListOptions) ([]*github.Branch, *github.Response, error) {
+		opts.ListOptions = listOpts
+		return b.client.GetClient().Repositories.ListBranches(ctx, owner, repo, opts)
+	})
 	if err != nil {
 		return nil, b.client.HandleError(err)
 	}
 
-	return branches, nil
+	return result, nil
 }
 
 // GetBranch gets a specific branch and its protection status
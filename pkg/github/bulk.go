@@ -0,0 +1,451 @@
+package github
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/v69/github"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+)
+
+// Limits of bulk issue operations
+const (
+	// BulkMaxItems is the most issues a bulk operation may touch
+	BulkMaxItems = 100
+	// BulkDefaultMaxItems is the default limit of a bulk operation
+	BulkDefaultMaxItems = 30
+	// BulkMaxConcurrency is the most requests a bulk operation runs in parallel
+	BulkMaxConcurrency = 10
+	// BulkDefaultConcurrency is the default parallelism of a bulk operation
+	BulkDefaultConcurrency = 4
+)
+
+// Bulk actions
+const (
+	BulkAddLabels    = "add_labels"
+	BulkRemoveLabels = "remove_labels"
+	BulkClose        = "close"
+	BulkSetMilestone = "set_milestone"
+	BulkAssign       = "assign"
+	BulkComment      = "comment"
+	BulkLock         = "lock"
+)
+
+// Outcomes of a bulk operation for a single issue
+const (
+	BulkStatusPlanned = "planned"
+	BulkStatusDone    = "done"
+	BulkStatusSkipped = "skipped"
+	BulkStatusFailed  = "failed"
+)
+
+// BulkIssueAction is the change applied to every issue of a bulk operation
+type BulkIssueAction struct {
+	Type string
+	// Labels for add_labels and remove_labels
+	Labels []string
+	// StateReason for close: completed or not_planned
+	StateReason string
+	// Milestone for set_milestone: a title, a number or "none" to clear it
+	Milestone string
+	// Assignees for assign
+	Assignees []string
+	// Body for comment
+	Body string
+	// LockReason for lock (optional)
+	LockReason string
+}
+
+// Validate checks that the action has the parameters it needs
+func (a BulkIssueAction) Validate() error {
+	switch a.Type {
+	case BulkAddLabels, BulkRemoveLabels:
+		if len(a.Labels) == 0 {
+			return errors.NewValidationError(fmt.Sprintf("%s requires labels", a.Type))
+		}
+	case BulkClose:
+		if a.StateReason != "" && a.StateReason != StateReasonCompleted && a.StateReason != StateReasonNotPlanned {
+			return errors.NewValidationError(fmt.Sprintf("state_reason must be either %s or %s", StateReasonCompleted, StateReasonNotPlanned))
+		}
+	case BulkSetMilestone:
+		if a.Milestone == "" {
+			return errors.NewValidationError("set_milestone requires milestone (a title, a number or none)")
+		}
+	case BulkAssign:
+		if len(a.Assignees) == 0 {
+			return errors.NewValidationError("assign requires assignees")
+		}
+	case BulkComment:
+		if strings.TrimSpace(a.Body) == "" {
+			return errors.NewValidationError("comment requires body")
+		}
+	case BulkLock:
+		return validateLockReason(a.LockReason)
+	default:
+		return errors.NewValidationError(fmt.Sprintf("action must be one of: %s", strings.Join([]string{
+			BulkAddLabels, BulkRemoveLabels, BulkClose, BulkSetMilestone, BulkAssign, BulkComment, BulkLock,
+		}, ", ")))
+	}
+	return nil
+}
+
+// String describes the action
+func (a BulkIssueAction) String() string {
+	switch a.Type {
+	case BulkAddLabels:
+		return "add labels " + strings.Join(a.Labels, ", ")
+	case BulkRemoveLabels:
+		return "remove labels " + strings.Join(a.Labels, ", ")
+	case BulkClose:
+		reason := a.StateReason
+		if reason == "" {
+			reason = StateReasonCompleted
+		}
+		return "close as " + reason
+	case BulkSetMilestone:
+		if a.Milestone == "none" {
+			return "clear the milestone"
+		}
+		return "set milestone " + a.Milestone
+	case BulkAssign:
+		return "assign " + strings.Join(a.Assignees, ", ")
+	case BulkComment:
+		return fmt.Sprintf("comment %q", a.Body)
+	case BulkLock:
+		if a.LockReason != "" {
+			return "lock as " + a.LockReason
+		}
+		return "lock"
+	}
+	return a.Type
+}
+
+// BulkOptions controls a bulk operation
+type BulkOptions struct {
+	// MaxItems is the most issues the query may match; larger matches are refused
+	MaxItems int
+	// Concurrency is the number of issues updated in parallel
+	Concurrency int
+	// ConfirmToken is the token of the preview to apply; without it, only a preview is returned
+	ConfirmToken string
+}
+
+// BulkItemResult is the outcome of a bulk operation for a single issue
+type BulkItemResult struct {
+	Repository string
+	Number     int
+	Title      string
+	Status     string
+	Message    string
+}
+
+// BulkIssueResult is the outcome of a bulk operation
+type BulkIssueResult struct {
+	Query  string
+	Action BulkIssueAction
+	// Preview is true if nothing was changed; Token confirms this preview
+	Preview bool
+	Token   string
+	Items   []BulkItemResult
+}
+
+// bulkTarget is an issue matched by a bulk operation
+type bulkTarget struct {
+	owner, repo string
+	issue       *github.Issue
+}
+
+// BulkUpdateIssues applies one action to every issue or pull request matching a search query.
+// Without a confirm token it only returns a preview of the matched issues and a token; passing
+// that token applies the action, provided the query still matches exactly the same issues.
+// Queries matching more than MaxItems issues are refused. Failures are reported per issue.
+func (i *IssueOperations) BulkUpdateIssues(ctx context.Context, query string, action BulkIssueAction, opts BulkOptions) (*BulkIssueResult, error) {
+	// Validate parameters
+	if strings.TrimSpace(query) == "" {
+		return nil, errors.NewValidationError("query cannot be empty")
+	}
+	if err := action.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.MaxItems == 0 {
+		opts.MaxItems = BulkDefaultMaxItems
+	}
+	if opts.MaxItems < 1 || opts.MaxItems > BulkMaxItems {
+		return nil, errors.NewValidationError(fmt.Sprintf("max_items must be between 1 and %d", BulkMaxItems))
+	}
+	if opts.Concurrency == 0 {
+		opts.Concurrency = BulkDefaultConcurrency
+	}
+	if opts.Concurrency < 1 || opts.Concurrency > BulkMaxConcurrency {
+		return nil, errors.NewValidationError(fmt.Sprintf("concurrency must be between 1 and %d", BulkMaxConcurrency))
+	}
+
+	// Find the issues; BulkMaxItems fits on one page
+	search, err := NewSearchOperations(i.client, i.logger).SearchIssues(ctx, query, 1, BulkMaxItems)
+	if err != nil {
+		return nil, err
+	}
+	if search.TotalCount > opts.MaxItems {
+		return nil, errors.NewValidationError(fmt.Sprintf("the query matches %d issues, more than max_items (%d); narrow the query or raise max_items (at most %d)", search.TotalCount, opts.MaxItems, BulkMaxItems))
+	}
+	if search.IncompleteResults {
+		return nil, errors.NewConflictError("the search timed out and returned incomplete results; try again")
+	}
+
+	targets := make([]bulkTarget, 0, len(search.Items))
+	for _, issue := range search.Items {
+		owner, repo, ok := repositoryFromURL(issue.GetRepositoryURL())
+		if !ok {
+			return nil, errors.NewInternalError(fmt.Sprintf("cannot tell the repository of issue %s", issue.GetHTMLURL()))
+		}
+		targets = append(targets, bulkTarget{owner: owner, repo: repo, issue: issue})
+	}
+
+	result := &BulkIssueResult{
+		Query:  query,
+		Action: action,
+		Token:  bulkToken(query, action, targets),
+		Items:  make([]BulkItemResult, len(targets)),
+	}
+	for j, target := range targets {
+		result.Items[j] = BulkItemResult{
+			Repository: target.owner + "/" + target.repo,
+			Number:     target.issue.GetNumber(),
+			Title:      target.issue.GetTitle(),
+			Status:     BulkStatusPlanned,
+		}
+	}
+
+	if opts.ConfirmToken == "" {
+		result.Preview = true
+		return result, nil
+	}
+	if opts.ConfirmToken != result.Token {
+		return nil, errors.NewConflictError("the confirm token does not match: the query now matches different issues, or the action changed since the preview; run the preview again")
+	}
+
+	// Apply the action with bounded parallelism
+	milestones := &milestoneCache{ops: NewMilestoneOperations(i.client, i.logger), numbers: make(map[string]int)}
+	semaphore := make(chan struct{}, opts.Concurrency)
+	var wg sync.WaitGroup
+	for j := range targets {
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			item := &result.Items[j]
+			if err := ctx.Err(); err != nil {
+				item.Status, item.Message = BulkStatusFailed, err.Error()
+				return
+			}
+			item.Status, item.Message = i.applyBulkAction(ctx, targets[j], action, milestones)
+		}(j)
+	}
+	wg.Wait()
+
+	return result, nil
+}
+
+// applyBulkAction applies the action to a single issue and returns its status and a message
+func (i *IssueOperations) applyBulkAction(ctx context.Context, target bulkTarget, action BulkIssueAction, milestones *milestoneCache) (string, string) {
+	client := i.client.GetClient()
+	owner, repo, issue, number := target.owner, target.repo, target.issue, target.issue.GetNumber()
+
+	var err error
+	switch action.Type {
+	case BulkAddLabels:
+		missing := missingValues(action.Labels, labelNames(issue.Labels))
+		if len(missing) == 0 {
+			return BulkStatusSkipped, "already has the labels"
+		}
+		_, _, err = client.Issues.AddLabelsToIssue(ctx, owner, repo, number, missing)
+
+	case BulkRemoveLabels:
+		present := presentValues(action.Labels, labelNames(issue.Labels))
+		if len(present) == 0 {
+			return BulkStatusSkipped, "has none of the labels"
+		}
+		for _, label := range present {
+			var resp *github.Response
+			resp, err = client.Issues.RemoveLabelForIssue(ctx, owner, repo, number, label)
+			if err != nil && resp != nil && resp.StatusCode == http.StatusNotFound {
+				// Removed in the meantime
+				err = nil
+			}
+			if err != nil {
+				break
+			}
+		}
+
+	case BulkClose:
+		if issue.GetState() == "closed" {
+			return BulkStatusSkipped, "already closed"
+		}
+		_, err = i.CloseIssue(ctx, owner, repo, number, action.StateReason)
+
+	case BulkSetMilestone:
+		if action.Milestone == "none" {
+			if issue.Milestone == nil {
+				return BulkStatusSkipped, "has no milestone"
+			}
+			_, _, err = client.Issues.RemoveMilestone(ctx, owner, repo, number)
+			break
+		}
+		var milestone int
+		milestone, err = milestones.resolve(ctx, owner, repo, action.Milestone)
+		if err != nil {
+			break
+		}
+		if issue.GetMilestone().GetNumber() == milestone {
+			return BulkStatusSkipped, "already in the milestone"
+		}
+		_, _, err = client.Issues.Edit(ctx, owner, repo, number, &github.IssueRequest{Milestone: github.Int(milestone)})
+
+	case BulkAssign:
+		var assignees []string
+		for _, assignee := range issue.Assignees {
+			assignees = append(assignees, assignee.GetLogin())
+		}
+		missing := missingValues(action.Assignees, assignees)
+		if len(missing) == 0 {
+			return BulkStatusSkipped, "already assigned"
+		}
+		_, _, err = client.Issues.AddAssignees(ctx, owner, repo, number, missing)
+
+	case BulkComment:
+		_, _, err = client.Issues.CreateComment(ctx, owner, repo, number, &github.IssueComment{Body: github.String(action.Body)})
+
+	case BulkLock:
+		if issue.GetLocked() {
+			return BulkStatusSkipped, "already locked"
+		}
+		err = i.LockIssue(ctx, owner, repo, number, action.LockReason)
+	}
+
+	if err != nil {
+		if _, ok := errors.AsGitHubError(err); !ok {
+			err = i.client.HandleError(err)
+		}
+		return BulkStatusFailed, err.Error()
+	}
+	return BulkStatusDone, ""
+}
+
+// milestoneCache resolves milestone titles once per repository
+type milestoneCache struct {
+	ops     *MilestoneOperations
+	mu      sync.Mutex
+	numbers map[string]int
+	errs    map[string]error
+}
+
+// resolve returns the number of the milestone in the repository
+func (c *milestoneCache) resolve(ctx context.Context, owner, repo, milestone string) (int, error) {
+	key := owner + "/" + repo
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if number, ok := c.numbers[key]; ok {
+		return number, nil
+	}
+	if err, ok := c.errs[key]; ok {
+		return 0, err
+	}
+
+	resolved, err := c.ops.ResolveMilestone(ctx, owner, repo, milestone)
+	var number int
+	if err == nil {
+		if number, err = strconv.Atoi(resolved); err != nil || number <= 0 {
+			err = errors.NewValidationError(fmt.Sprintf("milestone must be a title, a number or none, not %q", milestone))
+		}
+	}
+	if err != nil {
+		if c.errs == nil {
+			c.errs = make(map[string]error)
+		}
+		c.errs[key] = err
+		return 0, err
+	}
+	c.numbers[key] = number
+	return number, nil
+}
+
+// bulkToken identifies a preview: the query, the action and the exact set of matched issues
+func bulkToken(query string, action BulkIssueAction, targets []bulkTarget) string {
+	keys := make([]string, len(targets))
+	for j, target := range targets {
+		keys[j] = fmt.Sprintf("%s/%s#%d", target.owner, target.repo, target.issue.GetNumber())
+	}
+	sort.Strings(keys)
+
+	// Marshalling a struct of strings and string slices cannot fail
+	encodedAction, _ := json.Marshal(action)
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%s\n%s", query, encodedAction, strings.Join(keys, ","))
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+// repositoryFromURL extracts owner and repository from an API repository URL
+func repositoryFromURL(url string) (string, string, bool) {
+	index := strings.LastIndex(url, "/repos/")
+	if index == -1 {
+		return "", "", false
+	}
+	parts := strings.Split(url[index+len("/repos/"):], "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// labelNames returns the names of labels
+func labelNames(labels []*github.Label) []string {
+	names := make([]string, len(labels))
+	for j, label := range labels {
+		names[j] = label.GetName()
+	}
+	return names
+}
+
+// missingValues returns the wanted values that are not in have, case-insensitively
+func missingValues(wanted, have []string) []string {
+	var missing []string
+	for _, value := range wanted {
+		if !containsFold(have, value) {
+			missing = append(missing, value)
+		}
+	}
+	return missing
+}
+
+// presentValues returns the values of have that are wanted, case-insensitively
+func presentValues(wanted, have []string) []string {
+	var present []string
+	for _, value := range have {
+		if containsFold(wanted, value) {
+			present = append(present, value)
+		}
+	}
+	return present
+}
+
+// containsFold reports whether values contains value, case-insensitively
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+)
+
+func TestBulkUpdateIssues(t *testing.T) {
+	var mu sync.Mutex
+	var labelled []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/search/issues":
+			w.Write([]byte(`{"total_count": 3, "incomplete_results": false, "items": [
+				{"number": 1, "title": "One", "state": "open", "repository_url": "https://api.github.com/repos/octo/repo", "labels": []},
+				{"number": 2, "title": "Two", "state": "open", "repository_url": "https://api.github.com/repos/octo/repo", "labels": [{"name": "Stale"}]},
+				{"number": 3, "title": "Three", "state": "open", "repository_url": "https://api.github.com/repos/octo/other", "labels": []}
+			]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/octo/repo/issues/1/labels":
+			mu.Lock()
+			labelled = append(labelled, r.URL.Path)
+			mu.Unlock()
+			w.Write([]byte(`[{"name": "stale"}]`))
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/octo/other/issues/3/labels":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"message": "Must have push access"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	issueOps := NewIssueOperations(client, logrus.New())
+	action := BulkIssueAction{Type: BulkAddLabels, Labels: []string{"stale"}}
+
+	// Preview
+	preview, err := issueOps.BulkUpdateIssues(context.Background(), "is:open", action, BulkOptions{})
+	if err != nil {
+		t.Fatalf("BulkUpdateIssues() error = %v", err)
+	}
+	if !preview.Preview || preview.Token == "" || len(preview.Items) != 3 {
+		t.Fatalf("preview = %+v, want a preview of 3 issues with a token", preview)
+	}
+	if len(labelled) != 0 {
+		t.Fatalf("preview changed issues: %v", labelled)
+	}
+
+	// A token for another action is refused
+	other := BulkIssueAction{Type: BulkAddLabels, Labels: []string{"wontfix"}}
+	if _, err := issueOps.BulkUpdateIssues(context.Background(), "is:open", other, BulkOptions{ConfirmToken: preview.Token}); !errors.IsType(err, errors.ErrorTypeConflict) {
+		t.Errorf("BulkUpdateIssues() with another action error = %v, want conflict", err)
+	}
+
+	// Too many matches are refused
+	if _, err := issueOps.BulkUpdateIssues(context.Background(), "is:open", action, BulkOptions{MaxItems: 2}); err == nil {
+		t.Error("BulkUpdateIssues() with max_items 2 error = nil, want refusal")
+	}
+
+	// Apply
+	report, err := issueOps.BulkUpdateIssues(context.Background(), "is:open", action, BulkOptions{ConfirmToken: preview.Token, Concurrency: 2})
+	if err != nil {
+		t.Fatalf("BulkUpdateIssues() error = %v", err)
+	}
+	if report.Preview {
+		t.Error("report.Preview = true, want false")
+	}
+	var statuses []string
+	for _, item := range report.Items {
+		statuses = append(statuses, item.Repository+"#"+item.Status)
+	}
+	sort.Strings(statuses)
+	want := []string{"octo/other#failed", "octo/repo#done", "octo/repo#skipped"}
+	for j := range want {
+		if j >= len(statuses) || statuses[j] != want[j] {
+			t.Fatalf("statuses = %v, want %v", statuses, want)
+		}
+	}
+	if report.Items[2].Message == "" {
+		t.Error("failed item has no message")
+	}
+}
+
+func TestBulkIssueActionValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		action  BulkIssueAction
+		wantErr bool
+	}{
+		{name: "labels", action: BulkIssueAction{Type: BulkAddLabels, Labels: []string{"bug"}}},
+		{name: "labels missing", action: BulkIssueAction{Type: BulkRemoveLabels}, wantErr: true},
+		{name: "close", action: BulkIssueAction{Type: BulkClose}},
+		{name: "close invalid reason", action: BulkIssueAction{Type: BulkClose, StateReason: "duplicate"}, wantErr: true},
+		{name: "milestone missing", action: BulkIssueAction{Type: BulkSetMilestone}, wantErr: true},
+		{name: "comment blank", action: BulkIssueAction{Type: BulkComment, Body: " "}, wantErr: true},
+		{name: "lock", action: BulkIssueAction{Type: BulkLock, LockReason: "spam"}},
+		{name: "lock invalid reason", action: BulkIssueAction{Type: BulkLock, LockReason: "boring"}, wantErr: true},
+		{name: "unknown", action: BulkIssueAction{Type: "delete"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.action.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,33 +1,53 @@
 package github
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	stderrors "errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"os"
+	"time"
 
 	"github.com/google/go-github/v69/github"
 	"github.com/sirupsen/logrus"
 
 	"github.com/geropl/github-mcp-go/pkg/errors"
+	"github.com/geropl/github-mcp-go/pkg/redact"
 )
 
 // Client wraps the GitHub client and provides additional functionality
 type Client struct {
-	client *github.Client
-	logger *logrus.Logger
+	client     *github.Client
+	httpClient *http.Client
+	logger     *logrus.Logger
+}
+
+// ClientOptions configures the HTTP transport used to talk to GitHub
+type ClientOptions struct {
+	// ProxyURL is an explicit HTTP(S) proxy. If empty, the standard proxy environment variables are used.
+	ProxyURL string
+	// CABundlePath is a PEM file with CA certificates to trust in addition to the system roots
+	CABundlePath string
+	// Timeout limits each HTTP request. Zero means no timeout.
+	Timeout time.Duration
 }
 
 // NewClient creates a new GitHub client
 func NewClient(token string, logger *logrus.Logger) *Client {
-	httpClient := &http.Client{}
-	client := github.NewClient(httpClient)
-	if token != "" {
-		client = client.WithAuthToken(token)
-	}
+	return NewClientWithHTTPClient(token, &http.Client{}, logger)
+}
 
-	return &Client{
-		client: client,
-		logger: logger,
+// NewClientWithOptions creates a new GitHub client whose transport is configured from opts
+func NewClientWithOptions(token string, opts ClientOptions, logger *logrus.Logger) (*Client, error) {
+	httpClient, err := NewHTTPClient(opts)
+	if err != nil {
+		return nil, err
 	}
+
+	return NewClientWithHTTPClient(token, httpClient, logger), nil
 }
 
 // NewClientWithHTTPClient creates a new GitHub client with a custom HTTP client
@@ -38,74 +58,194 @@ func NewClientWithHTTPClient(token string, httpClient *http.Client, logger *logr
 	}
 
 	return &Client{
-		client: client,
-		logger: logger,
+		client:     client,
+		httpClient: httpClient,
+		logger:     logger,
 	}
 }
 
+// NewHTTPClient creates an HTTP client with the proxy, CA bundle and timeout from opts
+func NewHTTPClient(opts ClientOptions) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if opts.ProxyURL != "" {
+		proxyURL, err := url.Parse(opts.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL %q: %w", opts.ProxyURL, err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q: scheme and host are required", opts.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if opts.CABundlePath != "" {
+		pem, err := os.ReadFile(opts.CABundlePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid certificates found in CA bundle %s", opts.CABundlePath)
+		}
+
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    pool,
+		}
+	}
+
+	if opts.Timeout < 0 {
+		return nil, fmt.Errorf("timeout cannot be negative")
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   opts.Timeout,
+	}, nil
+}
+
 // GetClient returns the underlying GitHub client
 func (c *Client) GetClient() *github.Client {
 	return c.client
 }
 
+// GraphQL returns a GraphQL client that shares authentication and transport with this client
+func (c *Client) GraphQL() *GraphQLClient {
+	return NewGraphQLClient(c, c.logger)
+}
+
+// GetHTTPClient returns the unauthenticated HTTP client, used for downloads outside the GitHub API (e.g. signed log URLs)
+func (c *Client) GetHTTPClient() *http.Client {
+	return c.httpClient
+}
+
 // HandleError handles GitHub API errors
 func (c *Client) HandleError(err error) error {
 	if err == nil {
 		return nil
 	}
 
-	c.logger.WithError(err).Error("GitHub API error")
+	// Errors that were already mapped are passed through
+	if ghErr, ok := errors.AsGitHubError(err); ok {
+		return ghErr
+	}
+
+	c.logger.WithField("error", redact.String(err.Error())).Error("GitHub API error")
+
+	// Check if it's a rate limit error
+	var rateLimitErr *github.RateLimitError
+	if stderrors.As(err, &rateLimitErr) {
+		ghErr := errors.NewRateLimitErrorWithReset("GitHub API rate limit exceeded", rateLimitErr.Rate.Reset.Time, 0)
+		ghErr.StatusCode = responseStatusCode(rateLimitErr.Response)
+		ghErr.Err = err
+		return ghErr
+	}
+
+	// Check if it's a secondary rate limit error
+	var abuseErr *github.AbuseRateLimitError
+	if stderrors.As(err, &abuseErr) {
+		ghErr := errors.NewRateLimitErrorWithReset(abuseErr.Message, time.Time{}, abuseErr.GetRetryAfter())
+		ghErr.StatusCode = responseStatusCode(abuseErr.Response)
+		ghErr.Err = err
+		return ghErr
+	}
 
 	// Check if it's a GitHub error response
-	if ghErr, ok := err.(*github.ErrorResponse); ok {
-		statusCode := ghErr.Response.StatusCode
+	var errResp *github.ErrorResponse
+	if stderrors.As(err, &errResp) && errResp.Response != nil {
+		statusCode := errResp.Response.StatusCode
 		var responseBody interface{}
 
 		// Try to parse the response body
-		if ghErr.Response.Body != nil {
-			defer ghErr.Response.Body.Close()
+		if errResp.Response.Body != nil {
+			defer errResp.Response.Body.Close()
 			var data map[string]interface{}
-			if err := json.NewDecoder(ghErr.Response.Body).Decode(&data); err == nil {
+			if err := json.NewDecoder(errResp.Response.Body).Decode(&data); err == nil {
 				responseBody = data
 			}
 		}
 
-		return errors.CreateGitHubError(statusCode, responseBody)
-	}
+		// Fall back to the fields go-github already parsed
+		if responseBody == nil {
+			responseBody = errorResponseToMap(errResp)
+		}
 
-	// Check if it's a rate limit error
-	if rateLimitErr, ok := err.(*github.RateLimitError); ok {
-		resetAt := rateLimitErr.Rate.Reset.Time.String()
-		return errors.NewRateLimitError("GitHub API rate limit exceeded", resetAt)
+		ghErr := errors.CreateGitHubErrorWithHeaders(statusCode, responseBody, errResp.Response.Header)
+		ghErr.Err = err
+		return ghErr
 	}
 
-	// Check if it's an authentication error
-	if _, ok := err.(*github.AcceptedError); ok {
-		return errors.NewInternalError("GitHub API returned 202 Accepted, operation is still in progress")
+	// Check if the operation is still in progress
+	var acceptedErr *github.AcceptedError
+	if stderrors.As(err, &acceptedErr) {
+		ghErr := errors.NewInternalError("GitHub API returned 202 Accepted, operation is still in progress")
+		ghErr.Err = err
+		return ghErr
 	}
 
 	// Generic error
-	return errors.NewInternalError("GitHub API error: " + err.Error())
+	ghErr := errors.NewInternalError("GitHub API error: " + err.Error())
+	ghErr.Err = err
+	return ghErr
+}
+
+// errorResponseToMap converts a parsed go-github ErrorResponse into the JSON shape of a GitHub error body
+func errorResponseToMap(errResp *github.ErrorResponse) map[string]interface{} {
+	data := map[string]interface{}{
+		"message": errResp.Message,
+	}
+	if errResp.DocumentationURL != "" {
+		data["documentation_url"] = errResp.DocumentationURL
+	}
+	if len(errResp.Errors) > 0 {
+		details := make([]interface{}, 0, len(errResp.Errors))
+		for _, e := range errResp.Errors {
+			details = append(details, map[string]interface{}{
+				"resource": e.Resource,
+				"field":    e.Field,
+				"code":     e.Code,
+				"message":  e.Message,
+			})
+		}
+		data["errors"] = details
+	}
+	return data
+}
+
+// responseStatusCode returns the status code of resp, or 0 if there is no response
+func responseStatusCode(resp *http.Response) int {
+	if resp == nil {
+		return 0
+	}
+	return resp.StatusCode
 }
 
 // IsNotFound checks if an error is a not found error
 func (c *Client) IsNotFound(err error) bool {
-	if ghErr, ok := err.(*github.ErrorResponse); ok {
-		return ghErr.Response.StatusCode == http.StatusNotFound
+	var errResp *github.ErrorResponse
+	if stderrors.As(err, &errResp) && errResp.Response != nil {
+		return errResp.Response.StatusCode == http.StatusNotFound
 	}
-	return false
+	return errors.IsType(err, errors.ErrorTypeNotFound)
 }
 
 // IsRateLimitError checks if an error is a rate limit error
 func (c *Client) IsRateLimitError(err error) bool {
-	_, ok := err.(*github.RateLimitError)
-	return ok
+	var rateLimitErr *github.RateLimitError
+	var abuseErr *github.AbuseRateLimitError
+	return stderrors.As(err, &rateLimitErr) || stderrors.As(err, &abuseErr) || errors.IsType(err, errors.ErrorTypeRateLimit)
 }
 
 // IsAuthenticationError checks if an error is an authentication error
 func (c *Client) IsAuthenticationError(err error) bool {
-	if ghErr, ok := err.(*github.ErrorResponse); ok {
-		return ghErr.Response.StatusCode == http.StatusUnauthorized
+	var errResp *github.ErrorResponse
+	if stderrors.As(err, &errResp) && errResp.Response != nil {
+		return errResp.Response.StatusCode == http.StatusUnauthorized
 	}
-	return false
+	return errors.IsType(err, errors.ErrorTypeAuthentication)
 }
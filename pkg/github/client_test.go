@@ -0,0 +1,141 @@
+package github
+
+import (
+	"encoding/pem"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/sirupsen/logrus"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+)
+
+// newTestClient creates a Client that sends all API requests to handler
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClientWithHTTPClient("test-token", server.Client(), logrus.New())
+	baseURL, _ := url.Parse(server.URL + "/")
+	client.GetClient().BaseURL = baseURL
+	return client
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caBundle, certPEM, 0644); err != nil {
+		t.Fatalf("Failed to write CA bundle: %v", err)
+	}
+
+	t.Run("TrustsCABundle", func(t *testing.T) {
+		httpClient, err := NewHTTPClient(ClientOptions{CABundlePath: caBundle, Timeout: 5 * time.Second})
+		if err != nil {
+			t.Fatalf("NewHTTPClient() error = %v", err)
+		}
+		if httpClient.Timeout != 5*time.Second {
+			t.Errorf("Timeout = %v, want %v", httpClient.Timeout, 5*time.Second)
+		}
+
+		resp, err := httpClient.Get(server.URL)
+		if err != nil {
+			t.Fatalf("GET with CA bundle failed: %v", err)
+		}
+		resp.Body.Close()
+	})
+
+	t.Run("RejectsUnknownCA", func(t *testing.T) {
+		httpClient, err := NewHTTPClient(ClientOptions{})
+		if err != nil {
+			t.Fatalf("NewHTTPClient() error = %v", err)
+		}
+
+		if resp, err := httpClient.Get(server.URL); err == nil {
+			resp.Body.Close()
+			t.Fatal("expected TLS verification error without CA bundle")
+		}
+	})
+
+	t.Run("UsesExplicitProxy", func(t *testing.T) {
+		httpClient, err := NewHTTPClient(ClientOptions{ProxyURL: "http://proxy.example.com:3128"})
+		if err != nil {
+			t.Fatalf("NewHTTPClient() error = %v", err)
+		}
+
+		req, _ := http.NewRequest(http.MethodGet, "https://api.github.com", nil)
+		proxy, err := httpClient.Transport.(*http.Transport).Proxy(req)
+		if err != nil {
+			t.Fatalf("Proxy() error = %v", err)
+		}
+		if proxy == nil || proxy.Host != "proxy.example.com:3128" {
+			t.Errorf("Proxy() = %v, want proxy.example.com:3128", proxy)
+		}
+	})
+
+	errorCases := []struct {
+		name string
+		opts ClientOptions
+	}{
+		{name: "InvalidProxy", opts: ClientOptions{ProxyURL: "proxy.example.com"}},
+		{name: "MissingCABundle", opts: ClientOptions{CABundlePath: filepath.Join(t.TempDir(), "missing.pem")}},
+		{name: "NegativeTimeout", opts: ClientOptions{Timeout: -time.Second}},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewHTTPClient(tc.opts); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestHandleError(t *testing.T) {
+	client := NewClientWithHTTPClient("", &http.Client{}, logrus.New())
+
+	errResp := &github.ErrorResponse{
+		Response: &http.Response{
+			StatusCode: http.StatusUnprocessableEntity,
+			Header:     http.Header{},
+		},
+		Message:          "Validation Failed",
+		DocumentationURL: "https://docs.github.com/rest/issues/issues#create-an-issue",
+		Errors:           []github.Error{{Resource: "Issue", Field: "title", Code: "missing_field"}},
+	}
+	wrapped := fmt.Errorf("creating issue: %w", errResp)
+
+	err := client.HandleError(wrapped)
+	ghErr, ok := errors.AsGitHubError(err)
+	if !ok {
+		t.Fatalf("HandleError() = %v (%T), want *errors.GitHubError", err, err)
+	}
+	if ghErr.Type != errors.ErrorTypeValidation {
+		t.Errorf("Type = %q, want %q", ghErr.Type, errors.ErrorTypeValidation)
+	}
+	if ghErr.DocumentationURL != errResp.DocumentationURL {
+		t.Errorf("DocumentationURL = %q, want %q", ghErr.DocumentationURL, errResp.DocumentationURL)
+	}
+	if len(ghErr.Details) != 1 || ghErr.Details[0].Field != "title" {
+		t.Errorf("Details = %+v, want the title field error", ghErr.Details)
+	}
+	if !stderrors.Is(err, errResp) {
+		t.Error("errors.Is() = false, want the go-github error to be reachable through Unwrap")
+	}
+
+	// Already mapped errors are passed through unchanged
+	if again := client.HandleError(err); again != err {
+		t.Errorf("HandleError(GitHubError) = %v, want the same error", again)
+	}
+}
@@ -0,0 +1,76 @@
+package github
+
+import (
+	"strings"
+)
+
+// CodeOwnersPaths are the locations GitHub looks for a CODEOWNERS file, in order of precedence
+var CodeOwnersPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// CodeOwnersRule is a single line of a CODEOWNERS file
+type CodeOwnersRule struct {
+	Pattern string
+	// Owners are "@user", "@org/team" or email addresses; empty if the pattern has no owners
+	Owners []string
+}
+
+// ParseCodeOwners parses the rules of a CODEOWNERS file, skipping comments and blank lines
+func ParseCodeOwners(content string) []CodeOwnersRule {
+	var rules []CodeOwnersRule
+	for _, line := range strings.Split(content, "\n") {
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		rules = append(rules, CodeOwnersRule{Pattern: fields[0], Owners: fields[1:]})
+	}
+	return rules
+}
+
+// CodeOwnersFor returns the owners of a file. As on GitHub, the last matching rule wins.
+func CodeOwnersFor(rules []CodeOwnersRule, path string) []string {
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].Matches(path) {
+			return rules[i].Owners
+		}
+	}
+	return nil
+}
+
+// Matches reports whether the rule applies to a file, following gitignore semantics:
+// patterns containing a "/" other than a trailing one are relative to the repository root,
+// other patterns match at any depth, and a pattern naming a directory covers everything in it.
+// A wildcard in the last segment only matches at that level, so "docs/*" does not own "docs/a/b.md".
+func (r CodeOwnersRule) Matches(path string) bool {
+	pattern := r.Pattern
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	if pattern == "" {
+		return false
+	}
+
+	if !strings.Contains(pattern, "/") {
+		pattern = "**/" + pattern
+	}
+	patternSegments := strings.Split(strings.TrimPrefix(pattern, "/"), "/")
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	// Only a literal last segment (or a trailing "/") names a directory whose contents the rule covers
+	if !dirOnly && strings.ContainsAny(patternSegments[len(patternSegments)-1], "*?[") {
+		return matchSegments(patternSegments, segments)
+	}
+	for n := 1; n <= len(segments); n++ {
+		// A directory-only pattern cannot match the file itself
+		if dirOnly && n == len(segments) {
+			break
+		}
+		if matchSegments(patternSegments, segments[:n]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package github
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCodeOwnersFor(t *testing.T) {
+	rules := ParseCodeOwners(`# Default owners
+*       @octo/maintainers
+
+*.go    @alice # Go code
+/docs/  @octo/docs
+docs/*  @octo/writers
+build/  @bob
+/cmd/*.go @carol
+apps/** @dave
+/vendor/
+`)
+
+	testCases := []struct {
+		path   string
+		owners string
+	}{
+		{path: "README.md", owners: "@octo/maintainers"},
+		{path: "pkg/tools/server.go", owners: "@alice"},
+		{path: "docs/guide/setup.md", owners: "@octo/docs"},
+		{path: "docs/getting-started.md", owners: "@octo/writers"},
+		{path: "docs/build-app/troubleshooting.md", owners: "@octo/docs"},
+		{path: "pkg/docs/notes.md", owners: "@octo/maintainers"},
+		{path: "tools/build/run.sh", owners: "@bob"},
+		{path: "build", owners: "@octo/maintainers"},
+		{path: "cmd/serve.go", owners: "@carol"},
+		{path: "cmd/sub/serve.go", owners: "@alice"},
+		{path: "apps/web/index.ts", owners: "@dave"},
+		{path: "vendor/lib/lib.go", owners: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			if got := strings.Join(CodeOwnersFor(rules, tc.path), " "); got != tc.owners {
+				t.Errorf("CodeOwnersFor(%q) = %q, want %q", tc.path, got, tc.owners)
+			}
+		})
+	}
+}
@@ -47,7 +47,7 @@ func (c *CommitOperations) GetCommit(ctx context.Context, owner, repo, sha strin
 }
 
 // ListCommits lists commits in a repository with filtering options
-func (c *CommitOperations) ListCommits(ctx context.Context, owner, repo, path, author string, since, until time.Time, perPage int) ([]*github.RepositoryCommit, error) {
+func (c *CommitOperations) ListCommits(ctx context.Context, owner, repo, path, author string, since, until time.Time, pagination PaginationOptions) (*ListResult[*github.RepositoryCommit], error) {
 	// Validate parameters
 	if owner == "" {
 		return nil, errors.NewValidationError("owner cannot be empty")
@@ -62,18 +62,18 @@ func (c *CommitOperations) ListCommits(ctx context.Context, owner, repo, path, a
 		Author: author,
 		Since:  since,
 		Until:  until,
-		ListOptions: github.ListOptions{
-			PerPage: perPage,
-		},
 	}
 
 	// List commits
-	commits, _, err := c.client.GetClient().Repositories.ListCommits(ctx, owner, repo, opts)
+	result, err := Paginate(ctx, pagination, 30, func(listOpts github.ListOptions) ([]*github.RepositoryCommit, *github.Response, error) {
+		opts.ListOptions = listOpts
+		return c.client.GetClient().Repositories.ListCommits(ctx, owner, repo, opts)
+	})
 	if err != nil {
 		return nil, c.client.HandleError(err)
 	}
 
-	return commits, nil
+	return result, nil
 }
 
 // CompareCommits compares two commits/branches and shows differences
@@ -162,7 +162,7 @@ func (c *CommitOperations) CreateCommitComment(ctx context.Context, owner, repo,
 }
 
 // ListCommitComments lists comments for a specific commit
-func (c *CommitOperations) ListCommitComments(ctx context.Context, owner, repo, sha string) ([]*github.RepositoryComment, error) {
+func (c *CommitOperations) ListCommitComments(ctx context.Context, owner, repo, sha string, pagination PaginationOptions) (*ListResult[*github.RepositoryComment], error) {
 	// Validate parameters
 	if owner == "" {
 		return nil, errors.NewValidationError("owner cannot be empty")
@@ -174,16 +174,17 @@ func (c *CommitOperations) ListCommitComments(ctx context.Context, owner, repo,
 		return nil, errors.NewValidationError("sha cannot be empty")
 	}
 
-	// List comments
-	comments, _, err := c.client.GetClient().Repositories.ListCommitComments(ctx, owner, repo, sha, nil)
+	// List comments (page size 0 leaves the page size to GitHub)
+	result, err := Paginate(ctx, pagination, 0, func(listOpts github.ListOptions) ([]*github.RepositoryComment, *github.Response, error) {
+		return c.client.GetClient().Repositories.ListCommitComments(ctx, owner, repo, sha, &listOpts)
+	})
 	if err != nil {
 		return nil, c.client.HandleError(err)
 	}
 
-	return comments, nil
+	return result, nil
 }
 
-
 // CreateCommit creates a new commit directly (without push)
 func (c *CommitOperations) CreateCommit(ctx context.Context, owner, repo, message, tree string, parents []string, author, committer *github.CommitAuthor) (*github.Commit, error) {
 	// Validate parameters
@@ -1,8 +1,18 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"fmt"
+	"mime"
+	"net/http"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/google/go-github/v69/github"
 	"github.com/sirupsen/logrus"
@@ -58,6 +68,481 @@ func (f *FileOperations) GetFileContents(ctx context.Context, owner, repo, path,
 	return fileContent, nil
 }
 
+// FileData is a file read with ReadFile
+type FileData struct {
+	Path        string
+	Name        string
+	SHA         string
+	Size        int
+	HTMLURL     string
+	DownloadURL string
+	// Content is the raw content of the file, or of the LFS object if it was resolved
+	Content []byte
+	// Binary is true if Content is not UTF-8 text
+	Binary bool
+	// MIMEType is detected from the content and the file extension
+	MIMEType string
+	// FromBlob is true if the file was too large for the contents API and was read from the Git blobs API
+	FromBlob bool
+	// TooLarge is true if the file was over the size limit passed to ReadFile; Content is then empty
+	TooLarge bool
+	// LFS is set if the file is a Git LFS pointer
+	LFS *LFSPointer
+	// LFSResolved is true if Content holds the LFS object instead of the pointer
+	LFSResolved bool
+}
+
+// IsImage reports whether the file is a binary image
+func (d *FileData) IsImage() bool {
+	return d.Binary && strings.HasPrefix(d.MIMEType, "image/")
+}
+
+// ReadFile reads a file or lists a directory. Exactly one of the results is set.
+// Files too large for the contents API (over 1 MB) are read from the Git blobs API, unless they are
+// larger than maxSize; then only their metadata is returned and TooLarge is set. If resolveLFS is
+// set, Git LFS pointers are replaced by the objects they point to.
+func (f *FileOperations) ReadFile(ctx context.Context, owner, repo, path, ref string, resolveLFS bool, maxSize int) (*FileData, []*github.RepositoryContent, error) {
+	result, err := f.GetFileContents(ctx, owner, repo, path, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	directory, ok := result.([]*github.RepositoryContent)
+	if ok {
+		return nil, directory, nil
+	}
+	file := result.(*github.RepositoryContent)
+	if file.GetType() != "file" {
+		return nil, nil, errors.NewValidationError(fmt.Sprintf("%s is a %s, not a file", path, file.GetType()))
+	}
+
+	data := &FileData{
+		Path:        file.GetPath(),
+		Name:        file.GetName(),
+		SHA:         file.GetSHA(),
+		Size:        file.GetSize(),
+		HTMLURL:     file.GetHTMLURL(),
+		DownloadURL: file.GetDownloadURL(),
+	}
+
+	// The contents API leaves out the content of files over 1 MB
+	encoded := ""
+	if file.Content != nil {
+		encoded = *file.Content
+	}
+	if file.GetEncoding() == "none" || (encoded == "" && file.GetSize() > 0) {
+		if file.GetSize() > maxSize {
+			data.TooLarge = true
+			data.MIMEType = DetectMIMEType(data.Path, nil)
+			return data, nil, nil
+		}
+		data.Content, _, err = f.client.GetClient().Git.GetBlobRaw(ctx, owner, repo, file.GetSHA())
+		if err != nil {
+			return nil, nil, f.client.HandleError(err)
+		}
+		data.FromBlob = true
+	} else {
+		data.Content, err = base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, nil, errors.NewInternalError("failed to decode file content: " + err.Error())
+		}
+	}
+
+	if pointer, ok := ParseLFSPointer(data.Content); ok {
+		data.LFS = pointer
+		if resolveLFS {
+			data.Content, err = f.DownloadLFSObject(ctx, owner, repo, pointer)
+			if err != nil {
+				return nil, nil, err
+			}
+			data.LFSResolved = true
+		}
+	}
+
+	data.Binary = IsBinary(data.Content)
+	data.MIMEType = DetectMIMEType(data.Path, data.Content)
+
+	return data, nil, nil
+}
+
+// IsBinary reports whether content is binary rather than UTF-8 text. Like git, it looks for a
+// NUL byte in the first 8000 bytes.
+func IsBinary(content []byte) bool {
+	sniff := content
+	if len(sniff) > 8000 {
+		sniff = sniff[:8000]
+	}
+	if bytes.IndexByte(sniff, 0) != -1 {
+		return true
+	}
+	return !utf8.Valid(content)
+}
+
+// DetectMIMEType determines the MIME type of a file from its extension, falling back to its content
+func DetectMIMEType(path string, content []byte) string {
+	if mimeType := mime.TypeByExtension(filepath.Ext(path)); mimeType != "" {
+		return strings.TrimSpace(strings.Split(mimeType, ";")[0])
+	}
+	return strings.TrimSpace(strings.Split(http.DetectContentType(content), ";")[0])
+}
+
+// Limits of file excerpts
+const (
+	// MaxContextLines is the most context lines shown around each match
+	MaxContextLines = 50
+	// MaxLineMatches is the most matching lines an excerpt contains
+	MaxLineMatches = 100
+)
+
+// ExcerptOptions selects the lines of a file excerpt; zero values select everything
+type ExcerptOptions struct {
+	// StartLine and EndLine are 1-based and inclusive
+	StartLine int
+	EndLine   int
+	// Pattern is a regular expression; only matching lines and their context are kept
+	Pattern string
+	// ContextLines is the number of lines shown before and after each match
+	ContextLines int
+}
+
+// Validate checks the line range, the context lines and the pattern
+func (o ExcerptOptions) Validate() error {
+	_, err := o.compile()
+	return err
+}
+
+// compile validates the options and compiles the pattern; it returns nil without a pattern
+func (o ExcerptOptions) compile() (*regexp.Regexp, error) {
+	if o.StartLine < 0 || o.EndLine < 0 {
+		return nil, errors.NewValidationError("start_line and end_line must be at least 1")
+	}
+	if o.EndLine > 0 && o.EndLine < o.StartLine {
+		return nil, errors.NewValidationError("end_line cannot be before start_line")
+	}
+	if o.ContextLines < 0 || o.ContextLines > MaxContextLines {
+		return nil, errors.NewValidationError(fmt.Sprintf("context_lines must be between 0 and %d", MaxContextLines))
+	}
+	if o.Pattern == "" {
+		return nil, nil
+	}
+	pattern, err := regexp.Compile(o.Pattern)
+	if err != nil {
+		return nil, errors.NewValidationError(fmt.Sprintf("invalid pattern: %v", err))
+	}
+	return pattern, nil
+}
+
+// ExcerptLine is a numbered line of a file excerpt
+type ExcerptLine struct {
+	Number int
+	Text   string
+	// Match is true if the line matches the pattern
+	Match bool
+}
+
+// FileExcerpt is a selection of the lines of a file
+type FileExcerpt struct {
+	Lines      []ExcerptLine
+	TotalLines int
+	// Matches is the number of lines matching the pattern
+	Matches int
+	// Truncated is true if more than MaxLineMatches lines matched
+	Truncated bool
+}
+
+// ExtractLines selects lines of a text file by range and pattern. With a pattern, the range
+// limits where matches are searched; context lines may extend beyond it.
+func ExtractLines(content string, opts ExcerptOptions) (*FileExcerpt, error) {
+	pattern, err := opts.compile()
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	if content == "" {
+		lines = nil
+	}
+	for j, line := range lines {
+		lines[j] = strings.TrimSuffix(line, "\r")
+	}
+
+	excerpt := &FileExcerpt{TotalLines: len(lines)}
+	start, end := 1, len(lines)
+	if opts.StartLine > 0 {
+		start = opts.StartLine
+	}
+	if opts.EndLine > 0 && opts.EndLine < end {
+		end = opts.EndLine
+	}
+	if opts.StartLine > 0 && start > len(lines) {
+		return nil, errors.NewValidationError(fmt.Sprintf("start_line %d is past the end of the file (%d lines)", start, len(lines)))
+	}
+
+	if pattern == nil {
+		for number := start; number <= end; number++ {
+			excerpt.Lines = append(excerpt.Lines, ExcerptLine{Number: number, Text: lines[number-1]})
+		}
+		return excerpt, nil
+	}
+
+	// Mark the matches and the lines around them
+	keep := make([]bool, len(lines)+1)
+	match := make([]bool, len(lines)+1)
+	for number := start; number <= end; number++ {
+		if !pattern.MatchString(lines[number-1]) {
+			continue
+		}
+		excerpt.Matches++
+		if excerpt.Matches > MaxLineMatches {
+			excerpt.Truncated = true
+			continue
+		}
+		match[number] = true
+		for n := max(1, number-opts.ContextLines); n <= min(len(lines), number+opts.ContextLines); n++ {
+			keep[n] = true
+		}
+	}
+	for number := 1; number <= len(lines); number++ {
+		if keep[number] {
+			excerpt.Lines = append(excerpt.Lines, ExcerptLine{Number: number, Text: lines[number-1], Match: match[number]})
+		}
+	}
+
+	return excerpt, nil
+}
+
+// Limits of repository tree listings
+const (
+	// DefaultTreeEntries is the default number of entries a tree listing returns
+	DefaultTreeEntries = 1000
+	// MaxTreeEntries is the most entries a tree listing returns
+	MaxTreeEntries = 10000
+	// maxTreeRequests limits the trees fetched one by one when GitHub truncates a recursive listing
+	maxTreeRequests = 100
+)
+
+// TreeOptions selects the entries of a repository tree listing
+type TreeOptions struct {
+	// Path is the directory to list (default: the repository root)
+	Path string
+	// Paths filters entries by their path in the repository
+	Paths PathFilter
+	// MaxDepth limits how deep below Path entries are listed; 1 lists direct children only, 0 is unlimited
+	MaxDepth int
+	// MaxEntries limits the number of entries returned
+	MaxEntries int
+}
+
+// TreeEntry is a file, directory or submodule of a repository tree listing
+type TreeEntry struct {
+	// Path is relative to the listed directory
+	Path string
+	// Type is blob (a file), tree (a directory) or commit (a submodule)
+	Type string
+	Size int
+	SHA  string
+}
+
+// RepositoryTree is a listing of a repository tree
+type RepositoryTree struct {
+	Ref  string
+	Path string
+	// SHA is the SHA of the listed tree
+	SHA     string
+	Entries []TreeEntry
+	// Files, Directories and TotalSize count the matching entries, including those beyond MaxEntries
+	Files       int
+	Directories int
+	TotalSize   int64
+	// Incomplete is true if GitHub truncated the listing and the rest could not be fetched
+	Incomplete bool
+	// Truncated is true if more than MaxEntries entries matched
+	Truncated bool
+}
+
+// GetRepositoryTree lists the files and directories of a repository tree using the Git trees API.
+// If GitHub truncates the recursive listing of a large tree, the directories are fetched one by
+// one instead, up to a limit; the result is then marked incomplete.
+func (f *FileOperations) GetRepositoryTree(ctx context.Context, owner, repo, ref string, opts TreeOptions) (*RepositoryTree, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if opts.MaxDepth < 0 {
+		return nil, errors.NewValidationError("max_depth cannot be negative")
+	}
+	if opts.MaxEntries == 0 {
+		opts.MaxEntries = DefaultTreeEntries
+	}
+	if opts.MaxEntries < 1 || opts.MaxEntries > MaxTreeEntries {
+		return nil, errors.NewValidationError(fmt.Sprintf("max_entries must be between 1 and %d", MaxTreeEntries))
+	}
+	if err := opts.Paths.Validate(); err != nil {
+		return nil, err
+	}
+
+	if ref == "" {
+		repository, _, err := f.client.GetClient().Repositories.Get(ctx, owner, repo)
+		if err != nil {
+			return nil, f.client.HandleError(err)
+		}
+		ref = repository.GetDefaultBranch()
+	}
+	dir := strings.Trim(opts.Path, "/")
+
+	// Find the tree of the directory to list
+	sha := ref
+	if dir != "" {
+		for _, segment := range strings.Split(dir, "/") {
+			tree, _, err := f.client.GetClient().Git.GetTree(ctx, owner, repo, sha, false)
+			if err != nil {
+				return nil, f.client.HandleError(err)
+			}
+			sha = ""
+			for _, entry := range tree.Entries {
+				if entry.GetPath() == segment && entry.GetType() == "tree" {
+					sha = entry.GetSHA()
+				}
+			}
+			if sha == "" {
+				return nil, errors.NewNotFoundError(fmt.Sprintf("directory %q not found at %s", dir, ref))
+			}
+		}
+	}
+
+	result := &RepositoryTree{Ref: ref, Path: dir}
+
+	// List the tree recursively, or directory by directory if GitHub truncates the listing
+	tree, _, err := f.client.GetClient().Git.GetTree(ctx, owner, repo, sha, true)
+	if err != nil {
+		return nil, f.client.HandleError(err)
+	}
+	result.SHA = tree.GetSHA()
+
+	var entries []TreeEntry
+	// unexplored holds directories whose contents were not listed
+	unexplored := make(map[string]bool)
+	if !tree.GetTruncated() {
+		for _, entry := range tree.Entries {
+			entries = append(entries, newTreeEntry("", entry))
+		}
+	} else {
+		entries, unexplored, result.Incomplete, err = f.walkTree(ctx, owner, repo, result.SHA, opts.MaxDepth)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Keep the matching entries and the directories that lead to them
+	repoPath := func(p string) string {
+		if dir == "" {
+			return p
+		}
+		return dir + "/" + p
+	}
+	keep := make(map[string]bool)
+	for _, entry := range entries {
+		if entry.Type == "tree" {
+			// Directories without listed contents cannot be checked for matches
+			if unexplored[entry.Path] && !opts.Paths.Excludes(repoPath(entry.Path)) {
+				keep[entry.Path] = true
+			}
+			continue
+		}
+		if !opts.Paths.Matches(repoPath(entry.Path)) {
+			continue
+		}
+		keep[entry.Path] = true
+		for parent := path.Dir(entry.Path); parent != "."; parent = path.Dir(parent) {
+			keep[parent] = true
+		}
+	}
+
+	// Sort by path segments, so that directories are followed by their contents
+	sort.Slice(entries, func(a, b int) bool {
+		return strings.ReplaceAll(entries[a].Path, "/", "\x00") < strings.ReplaceAll(entries[b].Path, "/", "\x00")
+	})
+	for _, entry := range entries {
+		if !keep[entry.Path] && !(opts.Paths.IsEmpty() && entry.Type == "tree") {
+			continue
+		}
+		if opts.MaxDepth > 0 && strings.Count(entry.Path, "/") >= opts.MaxDepth {
+			continue
+		}
+		if entry.Type == "tree" {
+			result.Directories++
+		} else {
+			result.Files++
+			result.TotalSize += int64(entry.Size)
+		}
+		if len(result.Entries) == opts.MaxEntries {
+			result.Truncated = true
+			continue
+		}
+		result.Entries = append(result.Entries, entry)
+	}
+
+	return result, nil
+}
+
+// walkTree lists a tree directory by directory, breadth-first and down to maxDepth (0 is unlimited).
+// It returns the entries, the directories whose contents were not fetched, and whether any of them
+// were skipped because of the request limit rather than maxDepth.
+func (f *FileOperations) walkTree(ctx context.Context, owner, repo, sha string, maxDepth int) ([]TreeEntry, map[string]bool, bool, error) {
+	type pending struct {
+		path, sha string
+	}
+
+	var entries []TreeEntry
+	unexplored := make(map[string]bool)
+	queue := []pending{{sha: sha}}
+	for requests := 0; len(queue) > 0; requests++ {
+		if requests == maxTreeRequests {
+			for _, dir := range queue {
+				unexplored[dir.path] = true
+			}
+			return entries, unexplored, true, nil
+		}
+		dir := queue[0]
+		queue = queue[1:]
+
+		tree, _, err := f.client.GetClient().Git.GetTree(ctx, owner, repo, dir.sha, false)
+		if err != nil {
+			return nil, nil, false, f.client.HandleError(err)
+		}
+		for _, item := range tree.Entries {
+			entry := newTreeEntry(dir.path, item)
+			entries = append(entries, entry)
+			if entry.Type != "tree" {
+				continue
+			}
+			if maxDepth > 0 && strings.Count(entry.Path, "/")+1 >= maxDepth {
+				unexplored[entry.Path] = true
+				continue
+			}
+			queue = append(queue, pending{path: entry.Path, sha: entry.SHA})
+		}
+	}
+
+	return entries, unexplored, false, nil
+}
+
+// newTreeEntry converts a Git tree entry below dir
+func newTreeEntry(dir string, entry *github.TreeEntry) TreeEntry {
+	entryPath := entry.GetPath()
+	if dir != "" {
+		entryPath = dir + "/" + entryPath
+	}
+	return TreeEntry{
+		Path: entryPath,
+		Type: entry.GetType(),
+		Size: entry.GetSize(),
+		SHA:  entry.GetSHA(),
+	}
+}
+
 // CreateOrUpdateFile creates or updates a file
 func (f *FileOperations) CreateOrUpdateFile(ctx context.Context, owner, repo, path, content, message, branch, sha string) (*github.RepositoryContentResponse, error) {
 	// Validate parameters
@@ -185,6 +670,9 @@ func (f *FileOperations) PushFiles(ctx context.Context, owner, repo, branch stri
 
 // DecodeFileContent decodes the base64-encoded content of a file
 func (f *FileOperations) DecodeFileContent(content *github.RepositoryContent) (string, error) {
+	if content.GetEncoding() == "none" {
+		return "", errors.NewValidationError(fmt.Sprintf("%s is too large for the contents API (%d bytes)", content.GetPath(), content.GetSize()))
+	}
 	if content.Content == nil {
 		return "", errors.NewInternalError("file content is nil")
 	}
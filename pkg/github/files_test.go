@@ -0,0 +1,295 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+const testLFSPointer = "version https://git-lfs.github.com/spec/v1\noid sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393\nsize 12345\n"
+
+func TestReadFile(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	var lfsServer string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/repos/octo/repo/contents/big.txt":
+			w.Write([]byte(`{"type": "file", "name": "big.txt", "path": "big.txt", "sha": "b1", "size": 2000000, "encoding": "none", "content": ""}`))
+		case "/repos/octo/repo/git/blobs/b1":
+			w.Write([]byte("large text"))
+		case "/repos/octo/repo/contents/logo.png":
+			w.Write([]byte(`{"type": "file", "name": "logo.png", "path": "logo.png", "sha": "p1", "size": 16, "encoding": "base64", "content": "` + base64.StdEncoding.EncodeToString(png) + `"}`))
+		case "/repos/octo/repo/contents/model.bin":
+			w.Write([]byte(`{"type": "file", "name": "model.bin", "path": "model.bin", "sha": "l1", "size": 131, "encoding": "base64", "content": "` + base64.StdEncoding.EncodeToString([]byte(testLFSPointer)) + `"}`))
+		case "/octo/repo.git/info/lfs/objects/batch":
+			var batch lfsBatchRequest
+			if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+				t.Fatalf("decoding request: %v", err)
+			}
+			if batch.Operation != "download" || batch.Objects[0].Size != 12345 {
+				t.Errorf("batch request = %+v, want a download of the pointer's object", batch)
+			}
+			w.Write([]byte(`{"objects": [{"oid": "4d7a", "size": 12345, "actions": {"download": {"href": "` + lfsServer + `/objects/4d7a", "header": {"X-Signature": "s"}}}}]}`))
+		case "/objects/4d7a":
+			if r.Header.Get("X-Signature") != "s" || r.Header.Get("Authorization") != "" {
+				t.Errorf("download headers = %v, want the action's header and no token", r.Header)
+			}
+			w.Write([]byte("\x00weights"))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	lfsServer = client.GetClient().BaseURL.String()
+	lfsServer = lfsServer[:len(lfsServer)-1]
+	fileOps := NewFileOperations(client, logrus.New())
+
+	// Files over 1 MB are read from the blobs API
+	file, _, err := fileOps.ReadFile(context.Background(), "octo", "repo", "big.txt", "", false, 3000000)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if !file.FromBlob || string(file.Content) != "large text" || file.Binary {
+		t.Errorf("file = %+v, want the text read from the blob", file)
+	}
+
+	// Files over the limit are not fetched
+	file, _, err = fileOps.ReadFile(context.Background(), "octo", "repo", "big.txt", "", false, 1000000)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if !file.TooLarge || file.Content != nil || file.Size != 2000000 {
+		t.Errorf("file = %+v, want only the metadata of the file", file)
+	}
+
+	// Images are detected as binary
+	file, _, err = fileOps.ReadFile(context.Background(), "octo", "repo", "logo.png", "", false, 1024)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if !file.IsImage() || file.MIMEType != "image/png" {
+		t.Errorf("file = %+v, want a PNG image", file)
+	}
+
+	// LFS pointers are recognized and resolved on request
+	file, _, err = fileOps.ReadFile(context.Background(), "octo", "repo", "model.bin", "", false, 1024)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if file.LFS == nil || file.LFS.Size != 12345 || file.LFSResolved {
+		t.Errorf("file = %+v, want an unresolved LFS pointer", file)
+	}
+	file, _, err = fileOps.ReadFile(context.Background(), "octo", "repo", "model.bin", "", true, 1024)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if !file.LFSResolved || string(file.Content) != "\x00weights" || !file.Binary {
+		t.Errorf("file = %+v, want the binary LFS object", file)
+	}
+}
+
+func TestParseLFSPointer(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    bool
+	}{
+		{name: "pointer", content: testLFSPointer, want: true},
+		{name: "missing size", content: "version https://git-lfs.github.com/spec/v1\noid sha256:abc\n"},
+		{name: "text", content: "# README\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pointer, ok := ParseLFSPointer([]byte(tt.content))
+			if ok != tt.want {
+				t.Fatalf("ParseLFSPointer() ok = %v, want %v", ok, tt.want)
+			}
+			if ok && pointer.OID != "4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393" {
+				t.Errorf("OID = %s", pointer.OID)
+			}
+		})
+	}
+}
+
+func TestExtractLines(t *testing.T) {
+	content := "one\r\ntwo\nthree\nfour\nfive\nsix\nseven\n"
+	tests := []struct {
+		name    string
+		opts    ExcerptOptions
+		want    []int
+		matches []int
+		wantErr bool
+	}{
+		{name: "all", want: []int{1, 2, 3, 4, 5, 6, 7}},
+		{name: "range", opts: ExcerptOptions{StartLine: 2, EndLine: 3}, want: []int{2, 3}},
+		{name: "range past end", opts: ExcerptOptions{StartLine: 6, EndLine: 100}, want: []int{6, 7}},
+		{name: "pattern with context", opts: ExcerptOptions{Pattern: "^(two|six)$", ContextLines: 1}, want: []int{1, 2, 3, 5, 6, 7}, matches: []int{2, 6}},
+		{name: "pattern within range", opts: ExcerptOptions{StartLine: 3, Pattern: "^(two|six)$"}, want: []int{6}, matches: []int{6}},
+		{name: "start past end", opts: ExcerptOptions{StartLine: 8}, wantErr: true},
+		{name: "end before start", opts: ExcerptOptions{StartLine: 3, EndLine: 2}, wantErr: true},
+		{name: "invalid pattern", opts: ExcerptOptions{Pattern: "("}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			excerpt, err := ExtractLines(content, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExtractLines() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			var got, matches []int
+			for _, line := range excerpt.Lines {
+				got = append(got, line.Number)
+				if line.Match {
+					matches = append(matches, line.Number)
+				}
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) || fmt.Sprint(matches) != fmt.Sprint(tt.matches) {
+				t.Errorf("lines = %v, matches = %v; want %v, %v", got, matches, tt.want, tt.matches)
+			}
+			if excerpt.TotalLines != 7 || excerpt.Lines[0].Text == "one\r" {
+				t.Errorf("excerpt = %+v, want 7 lines without carriage returns", excerpt)
+			}
+		})
+	}
+}
+
+func TestExtractLinesEmptyFile(t *testing.T) {
+	for _, opts := range []ExcerptOptions{{}, {Pattern: "TODO"}} {
+		excerpt, err := ExtractLines("", opts)
+		if err != nil {
+			t.Fatalf("ExtractLines(%+v) error = %v", opts, err)
+		}
+		if excerpt.TotalLines != 0 || len(excerpt.Lines) != 0 {
+			t.Errorf("ExtractLines(%+v) = %+v, want an empty excerpt", opts, excerpt)
+		}
+	}
+
+	if _, err := ExtractLines("", ExcerptOptions{StartLine: 1}); err == nil {
+		t.Error("ExtractLines() with start_line 1 expected an error for an empty file")
+	}
+}
+
+func TestExcerptOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    ExcerptOptions
+		wantErr bool
+	}{
+		{name: "empty", opts: ExcerptOptions{}},
+		{name: "range and pattern", opts: ExcerptOptions{StartLine: 2, EndLine: 4, Pattern: "(?i)todo", ContextLines: MaxContextLines}},
+		{name: "negative start", opts: ExcerptOptions{StartLine: -1}, wantErr: true},
+		{name: "end before start", opts: ExcerptOptions{StartLine: 3, EndLine: 2}, wantErr: true},
+		{name: "too much context", opts: ExcerptOptions{ContextLines: MaxContextLines + 1}, wantErr: true},
+		{name: "invalid pattern", opts: ExcerptOptions{Pattern: "("}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.opts.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetRepositoryTree(t *testing.T) {
+	recursive := `{"sha": "root", "truncated": %s, "tree": [
+		{"path": "README.md", "type": "blob", "size": 100, "sha": "r"},
+		{"path": "docs", "type": "tree", "sha": "d"},
+		{"path": "docs/guide.md", "type": "blob", "size": 200, "sha": "g"},
+		{"path": "pkg", "type": "tree", "sha": "p"},
+		{"path": "pkg/main.go", "type": "blob", "size": 300, "sha": "m"},
+		{"path": "pkg/main_test.go", "type": "blob", "size": 400, "sha": "t"},
+		{"path": "pkg/util", "type": "tree", "sha": "u"},
+		{"path": "pkg/util/util.go", "type": "blob", "size": 500, "sha": "v"}
+	]}`
+	truncated := "false"
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		recursiveListing := r.URL.Query().Get("recursive") != ""
+		switch {
+		case r.URL.Path == "/repos/octo/repo":
+			w.Write([]byte(`{"default_branch": "main"}`))
+		case r.URL.Path == "/repos/octo/repo/git/trees/main" && recursiveListing:
+			w.Write([]byte(fmt.Sprintf(recursive, truncated)))
+		case r.URL.Path == "/repos/octo/repo/git/trees/main" || r.URL.Path == "/repos/octo/repo/git/trees/root":
+			w.Write([]byte(`{"sha": "root", "tree": [
+				{"path": "README.md", "type": "blob", "size": 100, "sha": "r"},
+				{"path": "docs", "type": "tree", "sha": "d"},
+				{"path": "pkg", "type": "tree", "sha": "p"}
+			]}`))
+		case r.URL.Path == "/repos/octo/repo/git/trees/p" && recursiveListing:
+			w.Write([]byte(`{"sha": "p", "tree": [
+				{"path": "main.go", "type": "blob", "size": 300, "sha": "m"},
+				{"path": "util", "type": "tree", "sha": "u"},
+				{"path": "util/util.go", "type": "blob", "size": 500, "sha": "v"}
+			]}`))
+		case r.URL.Path == "/repos/octo/repo/git/trees/p":
+			w.Write([]byte(`{"sha": "p", "tree": [
+				{"path": "main.go", "type": "blob", "size": 300, "sha": "m"},
+				{"path": "util", "type": "tree", "sha": "u"}
+			]}`))
+		case r.URL.Path == "/repos/octo/repo/git/trees/d":
+			w.Write([]byte(`{"sha": "d", "tree": [{"path": "guide.md", "type": "blob", "size": 200, "sha": "g"}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+		}
+	})
+	fileOps := NewFileOperations(client, logrus.New())
+
+	paths := func(tree *RepositoryTree) string {
+		var result []string
+		for _, entry := range tree.Entries {
+			result = append(result, entry.Path)
+		}
+		return strings.Join(result, ",")
+	}
+
+	tests := []struct {
+		name      string
+		opts      TreeOptions
+		truncated string
+		want      string
+	}{
+		{name: "everything", want: "README.md,docs,docs/guide.md,pkg,pkg/main.go,pkg/main_test.go,pkg/util,pkg/util/util.go"},
+		{name: "filters", opts: TreeOptions{Paths: PathFilter{Include: []string{"*.go"}, Exclude: []string{"*_test.go"}}}, want: "pkg,pkg/main.go,pkg/util,pkg/util/util.go"},
+		{name: "depth", opts: TreeOptions{MaxDepth: 1}, want: "README.md,docs,pkg"},
+		{name: "path", opts: TreeOptions{Path: "pkg/"}, want: "main.go,util,util/util.go"},
+		{name: "max entries", opts: TreeOptions{MaxEntries: 2}, want: "README.md,docs"},
+		{name: "truncated walk", opts: TreeOptions{MaxDepth: 2}, truncated: "true", want: "README.md,docs,docs/guide.md,pkg,pkg/main.go,pkg/util"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			truncated = "false"
+			if tt.truncated != "" {
+				truncated = tt.truncated
+			}
+			tree, err := fileOps.GetRepositoryTree(context.Background(), "octo", "repo", "", tt.opts)
+			if err != nil {
+				t.Fatalf("GetRepositoryTree() error = %v", err)
+			}
+			if got := paths(tree); got != tt.want {
+				t.Errorf("entries = %s, want %s", got, tt.want)
+			}
+			if tree.Incomplete {
+				t.Error("Incomplete = true, want false")
+			}
+		})
+	}
+
+	truncated = "false"
+	tree, err := fileOps.GetRepositoryTree(context.Background(), "octo", "repo", "main", TreeOptions{MaxEntries: 2})
+	if err != nil {
+		t.Fatalf("GetRepositoryTree() error = %v", err)
+	}
+	if !tree.Truncated || tree.Files != 5 || tree.Directories != 3 || tree.TotalSize != 1500 {
+		t.Errorf("tree = %+v, want 5 files and 3 directories counted beyond max_entries", tree)
+	}
+}
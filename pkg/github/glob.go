@@ -0,0 +1,93 @@
+package github
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+)
+
+// PathFilter selects repository paths by glob patterns.
+// Patterns use path.Match syntax plus "**", which matches any number of directories.
+// Patterns without a "/" are matched against the base name, so "*.go" matches Go files in every directory.
+type PathFilter struct {
+	Include []string
+	Exclude []string
+}
+
+// Validate checks that all patterns are well-formed
+func (f PathFilter) Validate() error {
+	for _, pattern := range append(append([]string{}, f.Include...), f.Exclude...) {
+		if _, err := path.Match(strings.ReplaceAll(pattern, "**", "*"), ""); err != nil {
+			return errors.NewValidationError(fmt.Sprintf("invalid glob pattern %q", pattern))
+		}
+	}
+	return nil
+}
+
+// IsEmpty reports whether the filter has no patterns
+func (f PathFilter) IsEmpty() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0
+}
+
+// Excludes reports whether p matches an exclude pattern
+func (f PathFilter) Excludes(p string) bool {
+	for _, pattern := range f.Exclude {
+		if matchGlob(pattern, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches reports whether p matches at least one include pattern (if any) and no exclude pattern
+func (f PathFilter) Matches(p string) bool {
+	if f.Excludes(p) {
+		return false
+	}
+	if len(f.Include) == 0 {
+		return true
+	}
+	for _, pattern := range f.Include {
+		if matchGlob(pattern, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchGlob matches a slash-separated path against a glob pattern; malformed patterns never match
+func matchGlob(pattern, p string) bool {
+	pattern = strings.Trim(pattern, "/")
+	p = strings.Trim(p, "/")
+	if !strings.Contains(pattern, "/") && pattern != "**" {
+		matched, _ := path.Match(pattern, path.Base(p))
+		return matched
+	}
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(p, "/"))
+}
+
+// matchSegments matches path segments against pattern segments, where "**" matches zero or more segments
+func matchSegments(pattern, segments []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			// Try every possible number of segments for "**"
+			for i := 0; i <= len(segments); i++ {
+				if matchSegments(pattern[1:], segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segments) == 0 {
+			return false
+		}
+		if matched, _ := path.Match(pattern[0], segments[0]); !matched {
+			return false
+		}
+		pattern = pattern[1:]
+		segments = segments[1:]
+	}
+	return len(segments) == 0
+}
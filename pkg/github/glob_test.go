@@ -0,0 +1,34 @@
+package github
+
+import "testing"
+
+func TestPathFilter(t *testing.T) {
+	testCases := []struct {
+		name    string
+		filter  PathFilter
+		path    string
+		matches bool
+	}{
+		{name: "NoPatterns", filter: PathFilter{}, path: "a/b.go", matches: true},
+		{name: "BaseNamePattern", filter: PathFilter{Include: []string{"*.go"}}, path: "pkg/tools/server.go", matches: true},
+		{name: "BaseNamePatternMiss", filter: PathFilter{Include: []string{"*.go"}}, path: "README.md", matches: false},
+		{name: "AnchoredPattern", filter: PathFilter{Include: []string{"pkg/*.go"}}, path: "pkg/tools/server.go", matches: false},
+		{name: "DoubleStar", filter: PathFilter{Include: []string{"pkg/**/*.go"}}, path: "pkg/tools/server.go", matches: true},
+		{name: "DoubleStarZeroDirs", filter: PathFilter{Include: []string{"pkg/**/*.go"}}, path: "pkg/main.go", matches: true},
+		{name: "TrailingDoubleStar", filter: PathFilter{Include: []string{"testdata/**"}}, path: "testdata/TestReviews/a.yaml", matches: true},
+		{name: "Exclude", filter: PathFilter{Include: []string{"**"}, Exclude: []string{"*_test.go"}}, path: "pkg/github/glob_test.go", matches: false},
+		{name: "ExcludeOnly", filter: PathFilter{Exclude: []string{"vendor/**"}}, path: "cmd/serve.go", matches: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.filter.Matches(tc.path); got != tc.matches {
+				t.Errorf("Matches(%q) = %t, want %t", tc.path, got, tc.matches)
+			}
+		})
+	}
+
+	if err := (PathFilter{Include: []string{"[a-"}}).Validate(); err == nil {
+		t.Error("expected an error for a malformed pattern")
+	}
+}
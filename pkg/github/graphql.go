@@ -0,0 +1,225 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/sirupsen/logrus"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+)
+
+// GraphQLClient executes GraphQL queries against the GitHub API.
+// It sends requests through the REST client, so authentication, rate-limit tracking,
+// error mapping and the HTTP transport (including VCR recorders in tests) are shared.
+type GraphQLClient struct {
+	client *Client
+	logger *logrus.Logger
+}
+
+// NewGraphQLClient creates a new GraphQLClient
+func NewGraphQLClient(client *Client, logger *logrus.Logger) *GraphQLClient {
+	return &GraphQLClient{
+		client: client,
+		logger: logger,
+	}
+}
+
+// GraphQLError is a single entry of the "errors" array of a GraphQL response
+type GraphQLError struct {
+	Message string        `json:"message"`
+	Type    string        `json:"type,omitempty"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// graphQLRequest is the body of a GraphQL request
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// graphQLResponse is the body of a GraphQL response
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []GraphQLError  `json:"errors"`
+}
+
+// Query executes a GraphQL query and decodes the "data" field into result
+func (g *GraphQLClient) Query(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	return g.do(ctx, query, variables, result)
+}
+
+// Mutate executes a GraphQL mutation and decodes the "data" field into result
+func (g *GraphQLClient) Mutate(ctx context.Context, mutation string, variables map[string]interface{}, result interface{}) error {
+	return g.do(ctx, mutation, variables, result)
+}
+
+func (g *GraphQLClient) do(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	if strings.TrimSpace(query) == "" {
+		return errors.NewValidationError("query cannot be empty")
+	}
+
+	req, err := g.client.GetClient().NewRequest("POST", graphQLURL(g.client.GetClient().BaseURL), &graphQLRequest{
+		Query:     query,
+		Variables: variables,
+	})
+	if err != nil {
+		return g.client.HandleError(err)
+	}
+
+	var response graphQLResponse
+	resp, err := g.client.GetClient().Do(ctx, req, &response)
+	if err != nil {
+		return g.client.HandleError(err)
+	}
+
+	if len(response.Errors) > 0 {
+		return g.handleGraphQLErrors(resp, response.Errors)
+	}
+
+	if result != nil && len(response.Data) > 0 {
+		if err := json.Unmarshal(response.Data, result); err != nil {
+			return errors.NewInternalError("failed to decode GraphQL response: " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+// graphQLURL derives the GraphQL endpoint from the REST API base URL.
+// GitHub Enterprise Server serves REST under /api/v3/ but GraphQL at /api/graphql,
+// while github.com serves both from the API root.
+func graphQLURL(baseURL *url.URL) string {
+	if strings.HasSuffix(baseURL.Path, "/api/v3/") {
+		return baseURL.ResolveReference(&url.URL{Path: "../graphql"}).String()
+	}
+	return baseURL.ResolveReference(&url.URL{Path: "graphql"}).String()
+}
+
+// handleGraphQLErrors maps GraphQL errors, which GitHub returns with HTTP 200, to GitHubErrors
+func (g *GraphQLClient) handleGraphQLErrors(resp *github.Response, graphQLErrors []GraphQLError) error {
+	messages := make([]string, 0, len(graphQLErrors))
+	for _, e := range graphQLErrors {
+		messages = append(messages, e.Message)
+	}
+	message := strings.Join(messages, "; ")
+
+	g.logger.WithField("errors", message).Error("GitHub GraphQL error")
+
+	// The first error determines the error type
+	switch graphQLErrors[0].Type {
+	case "NOT_FOUND":
+		return errors.NewNotFoundError(message)
+	case "FORBIDDEN", "INSUFFICIENT_SCOPES":
+		return errors.NewPermissionError(message)
+	case "RATE_LIMITED":
+		var resetAt time.Time
+		if resp != nil {
+			resetAt = resp.Rate.Reset.Time
+		}
+		return errors.NewRateLimitErrorWithReset(message, resetAt, 0)
+	case "UNPROCESSABLE", "ARGUMENT_ERROR":
+		return errors.NewValidationError(message)
+	default:
+		return errors.NewGitHubAPIError(200, message, graphQLErrors)
+	}
+}
+
+// GraphQLQuery executes a GraphQL query and returns the decoded "data" field as T
+func GraphQLQuery[T any](ctx context.Context, g *GraphQLClient, query string, variables map[string]interface{}) (*T, error) {
+	var result T
+	if err := g.Query(ctx, query, variables, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GraphQLMutate executes a GraphQL mutation and returns the decoded "data" field as T
+func GraphQLMutate[T any](ctx context.Context, g *GraphQLClient, mutation string, variables map[string]interface{}) (*T, error) {
+	var result T
+	if err := g.Mutate(ctx, mutation, variables, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetPullRequestNodeID resolves the GraphQL node ID of a pull request
+func (g *GraphQLClient) GetPullRequestNodeID(ctx context.Context, owner, repo string, number int) (string, error) {
+	// Validate parameters
+	if owner == "" {
+		return "", errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return "", errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return "", errors.NewValidationError("number must be greater than 0")
+	}
+
+	result, err := GraphQLQuery[struct {
+		Repository struct {
+			PullRequest *struct {
+				ID string `json:"id"`
+			} `json:"pullRequest"`
+		} `json:"repository"`
+	}](ctx, g, `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) { id }
+  }
+}`, map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+	})
+	if err != nil {
+		return "", err
+	}
+	if result.Repository.PullRequest == nil {
+		return "", errors.NewNotFoundError(fmt.Sprintf("pull request #%d not found in %s/%s", number, owner, repo))
+	}
+
+	return result.Repository.PullRequest.ID, nil
+}
+
+// GetIssueNodeID resolves the GraphQL node ID of an issue
+func (g *GraphQLClient) GetIssueNodeID(ctx context.Context, owner, repo string, number int) (string, error) {
+	// Validate parameters
+	if owner == "" {
+		return "", errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return "", errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return "", errors.NewValidationError("number must be greater than 0")
+	}
+
+	result, err := GraphQLQuery[struct {
+		Repository struct {
+			Issue *struct {
+				ID string `json:"id"`
+			} `json:"issue"`
+		} `json:"repository"`
+	}](ctx, g, `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    issue(number: $number) { id }
+  }
+}`, map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+	})
+	if err != nil {
+		return "", err
+	}
+	if result.Repository.Issue == nil {
+		return "", errors.NewNotFoundError(fmt.Sprintf("issue #%d not found in %s/%s", number, owner, repo))
+	}
+
+	return result.Repository.Issue.ID, nil
+}
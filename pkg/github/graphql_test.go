@@ -0,0 +1,99 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+)
+
+// newTestGraphQLClient creates a GraphQLClient that sends requests to handler
+func newTestGraphQLClient(t *testing.T, handler http.HandlerFunc) *GraphQLClient {
+	return newTestClient(t, handler).GraphQL()
+}
+
+func TestGraphQLURL(t *testing.T) {
+	testCases := []struct {
+		name    string
+		baseURL string
+		want    string
+	}{
+		{
+			name:    "GitHubDotCom",
+			baseURL: "https://api.github.com/",
+			want:    "https://api.github.com/graphql",
+		},
+		{
+			name:    "Enterprise",
+			baseURL: "https://ghe.example.com/api/v3/",
+			want:    "https://ghe.example.com/api/graphql",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			baseURL, err := url.Parse(tc.baseURL)
+			if err != nil {
+				t.Fatalf("url.Parse() error = %v", err)
+			}
+			if got := graphQLURL(baseURL); got != tc.want {
+				t.Errorf("graphQLURL() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGraphQLErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   int
+		body     string
+		wantType string
+	}{
+		{
+			name:     "NotFound",
+			status:   http.StatusOK,
+			body:     `{"data":{"repository":null},"errors":[{"type":"NOT_FOUND","message":"Could not resolve to a Repository"}]}`,
+			wantType: errors.ErrorTypeNotFound,
+		},
+		{
+			name:     "RateLimited",
+			status:   http.StatusOK,
+			body:     `{"errors":[{"type":"RATE_LIMITED","message":"API rate limit exceeded"}]}`,
+			wantType: errors.ErrorTypeRateLimit,
+		},
+		{
+			name:     "Forbidden",
+			status:   http.StatusOK,
+			body:     `{"errors":[{"type":"FORBIDDEN","message":"Resource not accessible by integration"}]}`,
+			wantType: errors.ErrorTypePermission,
+		},
+		{
+			name:     "Unauthorized",
+			status:   http.StatusUnauthorized,
+			body:     `{"message":"Bad credentials"}`,
+			wantType: errors.ErrorTypeAuthentication,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := newTestGraphQLClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.body))
+			})
+
+			err := g.Query(context.Background(), "query { viewer { login } }", nil, nil)
+			ghErr, ok := err.(*errors.GitHubError)
+			if !ok {
+				t.Fatalf("error = %v (%T), want *errors.GitHubError", err, err)
+			}
+			if ghErr.Type != tc.wantType {
+				t.Errorf("error type = %q, want %q", ghErr.Type, tc.wantType)
+			}
+		})
+	}
+}
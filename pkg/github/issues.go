@@ -2,6 +2,8 @@ package github
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/go-github/v69/github"
@@ -24,8 +26,21 @@ func NewIssueOperations(client *Client, logger *logrus.Logger) *IssueOperations
 	}
 }
 
+// SubIssuesSummary is the completion rollup of an issue's direct sub-issues
+type SubIssuesSummary struct {
+	Total            int `json:"total"`
+	Completed        int `json:"completed"`
+	PercentCompleted int `json:"percent_completed"`
+}
+
+// Issue is a GitHub issue with the sub-issue rollup, which go-github does not decode
+type Issue struct {
+	*github.Issue
+	SubIssuesSummary *SubIssuesSummary `json:"sub_issues_summary,omitempty"`
+}
+
 // GetIssue gets a specific issue by number
-func (i *IssueOperations) GetIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error) {
+func (i *IssueOperations) GetIssue(ctx context.Context, owner, repo string, number int) (*Issue, error) {
 	// Validate parameters
 	if owner == "" {
 		return nil, errors.NewValidationError("owner cannot be empty")
@@ -38,7 +53,14 @@ func (i *IssueOperations) GetIssue(ctx context.Context, owner, repo string, numb
 	}
 
 	// Get issue
-	issue, _, err := i.client.GetClient().Issues.Get(ctx, owner, repo, number)
+	req, err := i.client.GetClient().NewRequest("GET", fmt.Sprintf("repos/%s/%s/issues/%d", owner, repo, number), nil)
+	if err != nil {
+		return nil, i.client.HandleError(err)
+	}
+	// Same media type as go-github's Issues.Get, which includes reactions
+	req.Header.Set("Accept", "application/vnd.github.squirrel-girl-preview")
+	issue := &Issue{Issue: &github.Issue{}}
+	_, err = i.client.GetClient().Do(ctx, req, issue)
 	if err != nil {
 		return nil, i.client.HandleError(err)
 	}
@@ -46,8 +68,9 @@ func (i *IssueOperations) GetIssue(ctx context.Context, owner, repo string, numb
 	return issue, nil
 }
 
-// ListIssues lists issues in a repository with filtering options
-func (i *IssueOperations) ListIssues(ctx context.Context, owner, repo, state, sort, direction string, labels []string, since time.Time) ([]*github.Issue, error) {
+// ListIssues lists issues in a repository with filtering options.
+// milestone is a milestone number, title, "none" or "*"; titles are resolved to numbers.
+func (i *IssueOperations) ListIssues(ctx context.Context, owner, repo, state, sort, direction string, labels []string, since time.Time, milestone string, pagination PaginationOptions) (*ListResult[*github.Issue], error) {
 	// Validate parameters
 	if owner == "" {
 		return nil, errors.NewValidationError("owner cannot be empty")
@@ -56,25 +79,31 @@ func (i *IssueOperations) ListIssues(ctx context.Context, owner, repo, state, so
 		return nil, errors.NewValidationError("repo cannot be empty")
 	}
 
+	milestone, err := NewMilestoneOperations(i.client, i.logger).ResolveMilestone(ctx, owner, repo, milestone)
+	if err != nil {
+		return nil, err
+	}
+
 	// Set up options
 	opts := &github.IssueListByRepoOptions{
+		Milestone: milestone,
 		State:     state,
 		Sort:      sort,
 		Direction: direction,
 		Labels:    labels,
 		Since:     since,
-		ListOptions: github.ListOptions{
-			PerPage: 30,
-		},
 	}
 
 	// List issues
-	issues, _, err := i.client.GetClient().Issues.ListByRepo(ctx, owner, repo, opts)
+	result, err := Paginate(ctx, pagination, 30, func(listOpts github.ListOptions) ([]*github.Issue, *github.Response, error) {
+		opts.ListOptions = listOpts
+		return i.client.GetClient().Issues.ListByRepo(ctx, owner, repo, opts)
+	})
 	if err != nil {
 		return nil, i.client.HandleError(err)
 	}
 
-	return issues, nil
+	return result, nil
 }
 
 // CreateIssue creates a new issue
@@ -119,8 +148,11 @@ func (i *IssueOperations) CreateIssue(ctx context.Context, owner, repo, title, b
 	return issue, nil
 }
 
-// UpdateIssue updates an existing issue
-func (i *IssueOperations) UpdateIssue(ctx context.Context, owner, repo string, number int, title, body, state string, labels []string, assignees []string, milestone int) (*github.Issue, error) {
+// UpdateIssue updates an existing issue. stateReason (completed, not_planned, duplicate or reopened)
+// implies the matching state when state is empty. Closing as duplicate requires duplicateOf, the
+// number of the original issue in the same repository, and marks the issue as its duplicate; the
+// other changes are applied first.
+func (i *IssueOperations) UpdateIssue(ctx context.Context, owner, repo string, number int, title, body, state, stateReason string, duplicateOf int, labels []string, assignees []string, milestone int) (*github.Issue, error) {
 	// Validate parameters
 	if owner == "" {
 		return nil, errors.NewValidationError("owner cannot be empty")
@@ -131,6 +163,33 @@ func (i *IssueOperations) UpdateIssue(ctx context.Context, owner, repo string, n
 	if number <= 0 {
 		return nil, errors.NewValidationError("number must be greater than 0")
 	}
+	if state != "" && state != "open" && state != "closed" {
+		return nil, errors.NewValidationError("state must be either open or closed")
+	}
+	if duplicateOf < 0 || duplicateOf == number {
+		return nil, errors.NewValidationError("duplicate_of must be the number of another issue")
+	}
+	if duplicateOf > 0 {
+		if stateReason == "" {
+			stateReason = StateReasonDuplicate
+		}
+		if stateReason != StateReasonDuplicate {
+			return nil, errors.NewValidationError(fmt.Sprintf("duplicate_of can only be used with state_reason %s", StateReasonDuplicate))
+		}
+	}
+	if stateReason != "" {
+		reasonState, ok := stateReasonStates[stateReason]
+		if !ok {
+			return nil, errors.NewValidationError(fmt.Sprintf("state_reason must be one of: %s, %s, %s, %s", StateReasonCompleted, StateReasonNotPlanned, StateReasonDuplicate, StateReasonReopened))
+		}
+		if state != "" && state != reasonState {
+			return nil, errors.NewValidationError(fmt.Sprintf("state_reason %s requires state %s", stateReason, reasonState))
+		}
+		state = reasonState
+	}
+	if stateReason == StateReasonDuplicate && duplicateOf == 0 {
+		return nil, errors.NewValidationError(fmt.Sprintf("state_reason %s requires duplicate_of", StateReasonDuplicate))
+	}
 
 	// Set up issue request
 	issueRequest := &github.IssueRequest{}
@@ -141,9 +200,6 @@ func (i *IssueOperations) UpdateIssue(ctx context.Context, owner, repo string, n
 	if body != "" {
 		issueRequest.Body = github.String(body)
 	}
-	if state != "" {
-		issueRequest.State = github.String(state)
-	}
 	if labels != nil && len(labels) > 0 {
 		issueRequest.Labels = &labels
 	}
@@ -154,6 +210,16 @@ func (i *IssueOperations) UpdateIssue(ctx context.Context, owner, repo string, n
 		issueRequest.Milestone = github.Int(milestone)
 	}
 
+	if duplicateOf > 0 {
+		return i.updateAndCloseAsDuplicate(ctx, owner, repo, number, duplicateOf, issueRequest)
+	}
+	if state != "" {
+		issueRequest.State = github.String(state)
+	}
+	if stateReason != "" {
+		issueRequest.StateReason = github.String(stateReason)
+	}
+
 	// Update issue
 	issue, _, err := i.client.GetClient().Issues.Edit(ctx, owner, repo, number, issueRequest)
 	if err != nil {
@@ -163,6 +229,165 @@ func (i *IssueOperations) UpdateIssue(ctx context.Context, owner, repo string, n
 	return issue, nil
 }
 
+// updateAndCloseAsDuplicate applies the other changes of an update, then closes the issue as a
+// duplicate. The REST API cannot link the original issue, so the close goes through GraphQL.
+func (i *IssueOperations) updateAndCloseAsDuplicate(ctx context.Context, owner, repo string, number, duplicateOf int, issueRequest *github.IssueRequest) (*github.Issue, error) {
+	edited := issueRequest.Title != nil || issueRequest.Body != nil || issueRequest.Labels != nil || issueRequest.Assignees != nil || issueRequest.Milestone != nil
+	if edited {
+		if _, _, err := i.client.GetClient().Issues.Edit(ctx, owner, repo, number, issueRequest); err != nil {
+			return nil, i.client.HandleError(err)
+		}
+	}
+
+	if err := i.closeAsDuplicate(ctx, owner, repo, number, duplicateOf); err != nil {
+		if ghErr, ok := errors.AsGitHubError(err); ok && edited {
+			ghErr.Message = fmt.Sprintf("issue #%d was updated, but closing it as a duplicate of #%d failed: %s", number, duplicateOf, ghErr.Message)
+		}
+		return nil, err
+	}
+
+	// Re-read the issue to return its final state
+	issue, _, err := i.client.GetClient().Issues.Get(ctx, owner, repo, number)
+	if err != nil {
+		return nil, i.client.HandleError(err)
+	}
+
+	return issue, nil
+}
+
+// closeAsDuplicate closes an issue as a duplicate of another issue in the same repository
+func (i *IssueOperations) closeAsDuplicate(ctx context.Context, owner, repo string, number, duplicateOf int) error {
+	graphQL := i.client.GraphQL()
+	issueID, err := graphQL.GetIssueNodeID(ctx, owner, repo, number)
+	if err != nil {
+		return err
+	}
+	duplicateID, err := graphQL.GetIssueNodeID(ctx, owner, repo, duplicateOf)
+	if err != nil {
+		return err
+	}
+
+	return graphQL.Mutate(ctx, `mutation($input: CloseIssueInput!) {
+  closeIssue(input: $input) { issue { id } }
+}`, map[string]interface{}{
+		"input": map[string]interface{}{
+			"issueId":          issueID,
+			"stateReason":      "DUPLICATE",
+			"duplicateIssueId": duplicateID,
+		},
+	}, nil)
+}
+
+// Reasons for the state of an issue
+const (
+	StateReasonCompleted  = "completed"
+	StateReasonNotPlanned = "not_planned"
+	StateReasonDuplicate  = "duplicate"
+	StateReasonReopened   = "reopened"
+)
+
+// stateReasonStates maps each state reason to the state it belongs to
+var stateReasonStates = map[string]string{
+	StateReasonCompleted:  "closed",
+	StateReasonNotPlanned: "closed",
+	StateReasonDuplicate:  "closed",
+	StateReasonReopened:   "open",
+}
+
+// CloseIssue closes an issue as completed or not planned
+func (i *IssueOperations) CloseIssue(ctx context.Context, owner, repo string, number int, reason string) (*github.Issue, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+	if reason == "" {
+		reason = StateReasonCompleted
+	}
+	if reason != StateReasonCompleted && reason != StateReasonNotPlanned {
+		return nil, errors.NewValidationError(fmt.Sprintf("reason must be either %s or %s", StateReasonCompleted, StateReasonNotPlanned))
+	}
+
+	// Close issue
+	issue, _, err := i.client.GetClient().Issues.Edit(ctx, owner, repo, number, &github.IssueRequest{
+		State:       github.String("closed"),
+		StateReason: github.String(reason),
+	})
+	if err != nil {
+		return nil, i.client.HandleError(err)
+	}
+
+	return issue, nil
+}
+
+// LockReasons lists the reasons GitHub accepts for locking a conversation
+var LockReasons = []string{"off-topic", "too heated", "resolved", "spam"}
+
+// LockIssue locks the conversation of an issue or pull request; reason is optional
+func (i *IssueOperations) LockIssue(ctx context.Context, owner, repo string, number int, reason string) error {
+	// Validate parameters
+	if owner == "" {
+		return errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return errors.NewValidationError("number must be greater than 0")
+	}
+	if err := validateLockReason(reason); err != nil {
+		return err
+	}
+
+	// Lock issue
+	_, err := i.client.GetClient().Issues.Lock(ctx, owner, repo, number, &github.LockIssueOptions{LockReason: reason})
+	if err != nil {
+		return i.client.HandleError(err)
+	}
+
+	return nil
+}
+
+// UnlockIssue unlocks the conversation of an issue or pull request
+func (i *IssueOperations) UnlockIssue(ctx context.Context, owner, repo string, number int) error {
+	// Validate parameters
+	if owner == "" {
+		return errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return errors.NewValidationError("number must be greater than 0")
+	}
+
+	// Unlock issue
+	_, err := i.client.GetClient().Issues.Unlock(ctx, owner, repo, number)
+	if err != nil {
+		return i.client.HandleError(err)
+	}
+
+	return nil
+}
+
+// validateLockReason checks that reason is empty or one of LockReasons
+func validateLockReason(reason string) error {
+	if reason == "" {
+		return nil
+	}
+	for _, valid := range LockReasons {
+		if reason == valid {
+			return nil
+		}
+	}
+	return errors.NewValidationError(fmt.Sprintf("lock reason must be one of: %s", strings.Join(LockReasons, ", ")))
+}
+
 // AddIssueComment adds a comment to an issue
 func (i *IssueOperations) AddIssueComment(ctx context.Context, owner, repo string, number int, body string) (*github.IssueComment, error) {
 	// Validate parameters
@@ -193,8 +418,178 @@ func (i *IssueOperations) AddIssueComment(ctx context.Context, owner, repo strin
 	return issueComment, nil
 }
 
+// UpdateIssueComment replaces the body of an issue or pull request comment written by the authenticated user
+func (i *IssueOperations) UpdateIssueComment(ctx context.Context, owner, repo string, commentID int64, body string) (*github.IssueComment, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if commentID <= 0 {
+		return nil, errors.NewValidationError("comment_id must be greater than 0")
+	}
+	if body == "" {
+		return nil, errors.NewValidationError("body cannot be empty")
+	}
+
+	if err := i.checkOwnComment(ctx, owner, repo, commentID); err != nil {
+		return nil, err
+	}
+
+	return i.editIssueComment(ctx, owner, repo, commentID, body)
+}
+
+// editIssueComment replaces the body of a comment without checking who wrote it
+func (i *IssueOperations) editIssueComment(ctx context.Context, owner, repo string, commentID int64, body string) (*github.IssueComment, error) {
+	comment, _, err := i.client.GetClient().Issues.EditComment(ctx, owner, repo, commentID, &github.IssueComment{
+		Body: github.String(body),
+	})
+	if err != nil {
+		return nil, i.client.HandleError(err)
+	}
+
+	return comment, nil
+}
+
+// authenticatedLogin returns the login of the user the client is authenticated as
+func (i *IssueOperations) authenticatedLogin(ctx context.Context) (string, error) {
+	user, _, err := i.client.GetClient().Users.Get(ctx, "")
+	if err != nil {
+		return "", i.client.HandleError(err)
+	}
+	return user.GetLogin(), nil
+}
+
+// checkOwnComment returns a permission error unless the comment was written by the authenticated user
+func (i *IssueOperations) checkOwnComment(ctx context.Context, owner, repo string, commentID int64) error {
+	login, err := i.authenticatedLogin(ctx)
+	if err != nil {
+		return err
+	}
+
+	comment, _, err := i.client.GetClient().Issues.GetComment(ctx, owner, repo, commentID)
+	if err != nil {
+		return i.client.HandleError(err)
+	}
+	if author := comment.GetUser().GetLogin(); author != login {
+		return errors.NewPermissionError(fmt.Sprintf("comment %d was written by %s, not by the authenticated user %s; only your own comments can be edited or deleted", commentID, author, login))
+	}
+	return nil
+}
+
+// DeleteIssueComment deletes an issue or pull request comment written by the authenticated user
+func (i *IssueOperations) DeleteIssueComment(ctx context.Context, owner, repo string, commentID int64) error {
+	// Validate parameters
+	if owner == "" {
+		return errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return errors.NewValidationError("repo cannot be empty")
+	}
+	if commentID <= 0 {
+		return errors.NewValidationError("comment_id must be greater than 0")
+	}
+
+	if err := i.checkOwnComment(ctx, owner, repo, commentID); err != nil {
+		return err
+	}
+
+	// Delete comment
+	_, err := i.client.GetClient().Issues.DeleteComment(ctx, owner, repo, commentID)
+	if err != nil {
+		return i.client.HandleError(err)
+	}
+
+	return nil
+}
+
+// Comment upsert actions
+const (
+	CommentUpsertCreated   = "created"
+	CommentUpsertUpdated   = "updated"
+	CommentUpsertUnchanged = "unchanged"
+)
+
+// CommentUpsertResult is the outcome of upserting a comment
+type CommentUpsertResult struct {
+	Comment *github.IssueComment
+	Action  string
+}
+
+// CommentMarker returns the hidden HTML comment that identifies an upserted comment
+func CommentMarker(marker string) string {
+	return fmt.Sprintf("<!-- %s -->", marker)
+}
+
+// UpsertIssueComment updates the first comment by the authenticated user on an issue or pull request that
+// contains the hidden marker, or creates the comment if there is none. The marker is appended to the body if missing,
+// so the comment is found again next time.
+func (i *IssueOperations) UpsertIssueComment(ctx context.Context, owner, repo string, number int, marker, body string) (*CommentUpsertResult, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+	if body == "" {
+		return nil, errors.NewValidationError("body cannot be empty")
+	}
+	marker = strings.TrimSpace(marker)
+	if marker == "" {
+		return nil, errors.NewValidationError("marker cannot be empty")
+	}
+	if strings.Contains(marker, "--") || strings.ContainsAny(marker, "<>\n") {
+		return nil, errors.NewValidationError("marker cannot contain \"--\", \"<\", \">\" or line breaks")
+	}
+
+	tag := CommentMarker(marker)
+	if !strings.Contains(body, tag) {
+		body = body + "\n\n" + tag
+	}
+
+	// Only the authenticated user's comments are considered, so a copied marker cannot hijack someone else's comment
+	login, err := i.authenticatedLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := i.ListIssueComments(ctx, owner, repo, number, "created", "asc", nil, PaginationOptions{PerPage: MaxPerPage, MaxItems: MaxItemsLimit})
+	if err != nil {
+		return nil, err
+	}
+	for _, comment := range comments.Items {
+		if comment.GetUser().GetLogin() != login || !strings.Contains(comment.GetBody(), tag) {
+			continue
+		}
+		if comment.GetBody() == body {
+			return &CommentUpsertResult{Comment: comment, Action: CommentUpsertUnchanged}, nil
+		}
+		updated, err := i.editIssueComment(ctx, owner, repo, comment.GetID(), body)
+		if err != nil {
+			return nil, err
+		}
+		return &CommentUpsertResult{Comment: updated, Action: CommentUpsertUpdated}, nil
+	}
+	if comments.Truncated {
+		// Creating a comment could duplicate one we did not get to see
+		return nil, errors.NewValidationError(fmt.Sprintf("issue #%d has more than %d comments; cannot tell whether a comment with marker %q exists", number, MaxItemsLimit, marker))
+	}
+
+	created, err := i.AddIssueComment(ctx, owner, repo, number, body)
+	if err != nil {
+		return nil, err
+	}
+	return &CommentUpsertResult{Comment: created, Action: CommentUpsertCreated}, nil
+}
+
 // ListIssueComments lists comments on an issue
-func (i *IssueOperations) ListIssueComments(ctx context.Context, owner, repo string, number int, sort, direction string, since *time.Time) ([]*github.IssueComment, error) {
+func (i *IssueOperations) ListIssueComments(ctx context.Context, owner, repo string, number int, sort, direction string, since *time.Time, pagination PaginationOptions) (*ListResult[*github.IssueComment], error) {
 	// Validate parameters
 	if owner == "" {
 		return nil, errors.NewValidationError("owner cannot be empty")
@@ -211,16 +606,367 @@ func (i *IssueOperations) ListIssueComments(ctx context.Context, owner, repo str
 		Sort:      github.String(sort),
 		Direction: github.String(direction),
 		Since:     since,
-		ListOptions: github.ListOptions{
-			PerPage: 30,
-		},
 	}
 
 	// List comments
-	comments, _, err := i.client.GetClient().Issues.ListComments(ctx, owner, repo, number, opts)
+	result, err := Paginate(ctx, pagination, 30, func(listOpts github.ListOptions) ([]*github.IssueComment, *github.Response, error) {
+		opts.ListOptions = listOpts
+		return i.client.GetClient().Issues.ListComments(ctx, owner, repo, number, opts)
+	})
 	if err != nil {
 		return nil, i.client.HandleError(err)
 	}
 
-	return comments, nil
+	return result, nil
+}
+
+// ListIssueTimeline lists the timeline events of an issue or pull request in the order GitHub records them;
+// commits show their commit date, which can be older than the events around them.
+// If events is not empty, only events of those types (e.g. "labeled", "closed") are returned.
+func (i *IssueOperations) ListIssueTimeline(ctx context.Context, owner, repo string, number int, events []string, pagination PaginationOptions) (*ListResult[*github.Timeline], error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+
+	var keep func(event *github.Timeline) bool
+	if len(events) > 0 {
+		wanted := make(map[string]bool, len(events))
+		for _, event := range events {
+			wanted[event] = true
+		}
+		keep = func(event *github.Timeline) bool {
+			return wanted[event.GetEvent()]
+		}
+	}
+
+	// List timeline events
+	result, err := PaginateFiltered(ctx, pagination, 30, keep, func(listOpts github.ListOptions) ([]*github.Timeline, *github.Response, error) {
+		return i.client.GetClient().Issues.ListIssueTimeline(ctx, owner, repo, number, &listOpts)
+	})
+	if err != nil {
+		return nil, i.client.HandleError(err)
+	}
+
+	return result, nil
+}
+
+// TimelineEventTime returns when a timeline event happened.
+// Commits carry no created_at and reviews use submitted_at instead.
+func TimelineEventTime(event *github.Timeline) time.Time {
+	switch {
+	case event.CreatedAt != nil:
+		return event.GetCreatedAt().Time
+	case event.SubmittedAt != nil:
+		return event.GetSubmittedAt().Time
+	case event.Committer != nil && event.Committer.Date != nil:
+		return event.GetCommitter().GetDate().Time
+	case event.Author != nil && event.Author.Date != nil:
+		return event.GetAuthor().GetDate().Time
+	}
+	return time.Time{}
+}
+
+// IssueReference is a short description of an issue, possibly in another repository
+type IssueReference struct {
+	Number      int    `json:"number"`
+	Title       string `json:"title"`
+	State       string `json:"state"`
+	StateReason string `json:"stateReason"`
+	URL         string `json:"url"`
+	Repository  struct {
+		NameWithOwner string `json:"nameWithOwner"`
+	} `json:"repository"`
+	SubIssuesSummary SubIssuesSummary `json:"subIssuesSummary"`
+}
+
+// IssueHierarchy is an issue with its parent and sub-issues
+type IssueHierarchy struct {
+	Issue     IssueReference
+	Parent    *IssueReference
+	SubIssues []IssueReference
+	// TotalSubIssues may exceed len(SubIssues) if the issue has more than MaxSubIssues sub-issues
+	TotalSubIssues int
+}
+
+// MaxSubIssues is the number of sub-issues fetched for an issue hierarchy
+const MaxSubIssues = 100
+
+// issueReferenceFields selects the fields of an IssueReference
+const issueReferenceFields = `number title state stateReason url
+repository { nameWithOwner }
+subIssuesSummary { total completed percent_completed: percentCompleted }`
+
+// GetIssueHierarchy gets the parent and the sub-issues of an issue, with completion rollups
+func (i *IssueOperations) GetIssueHierarchy(ctx context.Context, owner, repo string, number int) (*IssueHierarchy, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+
+	result, err := GraphQLQuery[struct {
+		Repository struct {
+			Issue *struct {
+				IssueReference
+				Parent    *IssueReference `json:"parent"`
+				SubIssues struct {
+					TotalCount int              `json:"totalCount"`
+					Nodes      []IssueReference `json:"nodes"`
+				} `json:"subIssues"`
+			} `json:"issue"`
+		} `json:"repository"`
+	}](ctx, i.client.GraphQL(), `query($owner: String!, $repo: String!, $number: Int!, $first: Int!) {
+  repository(owner: $owner, name: $repo) {
+    issue(number: $number) {
+      `+issueReferenceFields+`
+      parent { `+issueReferenceFields+` }
+      subIssues(first: $first) {
+        totalCount
+        nodes { `+issueReferenceFields+` }
+      }
+    }
+  }
+}`, map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+		"first":  MaxSubIssues,
+	})
+	if err != nil {
+		return nil, err
+	}
+	issue := result.Repository.Issue
+	if issue == nil {
+		return nil, errors.NewNotFoundError(fmt.Sprintf("issue #%d not found in %s/%s", number, owner, repo))
+	}
+
+	return &IssueHierarchy{
+		Issue:          issue.IssueReference,
+		Parent:         issue.Parent,
+		SubIssues:      issue.SubIssues.Nodes,
+		TotalSubIssues: issue.SubIssues.TotalCount,
+	}, nil
+}
+
+// AddSubIssue makes an issue, possibly from another repository, a sub-issue of another.
+// An issue has at most one parent; with replaceParent, an existing parent is replaced.
+// It returns the updated hierarchy of the parent.
+func (i *IssueOperations) AddSubIssue(ctx context.Context, owner, repo string, number int, subOwner, subRepo string, subNumber int, replaceParent bool) (*IssueHierarchy, error) {
+	// Validate parameters
+	if subNumber <= 0 {
+		return nil, errors.NewValidationError("sub_issue_number must be greater than 0")
+	}
+	if subOwner == "" {
+		subOwner = owner
+	}
+	if subRepo == "" {
+		subRepo = repo
+	}
+	if subOwner == owner && subRepo == repo && subNumber == number {
+		return nil, errors.NewValidationError("an issue cannot be its own sub-issue")
+	}
+
+	graphQL := i.client.GraphQL()
+	issueID, err := graphQL.GetIssueNodeID(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+	subIssueID, err := graphQL.GetIssueNodeID(ctx, subOwner, subRepo, subNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add sub-issue
+	err = graphQL.Mutate(ctx, `mutation($input: AddSubIssueInput!) {
+  addSubIssue(input: $input) { issue { id } }
+}`, map[string]interface{}{
+		"input": map[string]interface{}{
+			"issueId":       issueID,
+			"subIssueId":    subIssueID,
+			"replaceParent": replaceParent,
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.GetIssueHierarchy(ctx, owner, repo, number)
+}
+
+// RemoveSubIssue removes a sub-issue from its parent; both issues are kept.
+// It returns the updated hierarchy of the parent.
+func (i *IssueOperations) RemoveSubIssue(ctx context.Context, owner, repo string, number int, subOwner, subRepo string, subNumber int) (*IssueHierarchy, error) {
+	// Validate parameters
+	if subNumber <= 0 {
+		return nil, errors.NewValidationError("sub_issue_number must be greater than 0")
+	}
+	if subOwner == "" {
+		subOwner = owner
+	}
+	if subRepo == "" {
+		subRepo = repo
+	}
+
+	graphQL := i.client.GraphQL()
+	issueID, err := graphQL.GetIssueNodeID(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+	subIssueID, err := graphQL.GetIssueNodeID(ctx, subOwner, subRepo, subNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove sub-issue
+	err = graphQL.Mutate(ctx, `mutation($input: RemoveSubIssueInput!) {
+  removeSubIssue(input: $input) { issue { id } }
+}`, map[string]interface{}{
+		"input": map[string]interface{}{
+			"issueId":    issueID,
+			"subIssueId": subIssueID,
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.GetIssueHierarchy(ctx, owner, repo, number)
+}
+
+// TransferIssue moves an issue to another repository of the same owner or organization.
+// Labels and milestones that do not exist in the target are dropped unless createLabels is set,
+// in which case missing labels are created. It returns the issue at its new location.
+func (i *IssueOperations) TransferIssue(ctx context.Context, owner, repo string, number int, targetOwner, targetRepo string, createLabels bool) (*IssueReference, error) {
+	// Validate parameters
+	if targetRepo == "" {
+		return nil, errors.NewValidationError("target_repo cannot be empty")
+	}
+	if targetOwner == "" {
+		targetOwner = owner
+	}
+	if targetOwner == owner && targetRepo == repo {
+		return nil, errors.NewValidationError("the issue is already in the target repository")
+	}
+
+	graphQL := i.client.GraphQL()
+	issueID, err := graphQL.GetIssueNodeID(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+	target, err := GraphQLQuery[struct {
+		Repository *struct {
+			ID string `json:"id"`
+		} `json:"repository"`
+	}](ctx, graphQL, `query($owner: String!, $repo: String!) {
+  repository(owner: $owner, name: $repo) { id }
+}`, map[string]interface{}{
+		"owner": targetOwner,
+		"repo":  targetRepo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if target.Repository == nil {
+		return nil, errors.NewNotFoundError(fmt.Sprintf("repository %s/%s not found", targetOwner, targetRepo))
+	}
+
+	// Transfer issue
+	result, err := GraphQLMutate[struct {
+		TransferIssue struct {
+			Issue IssueReference `json:"issue"`
+		} `json:"transferIssue"`
+	}](ctx, graphQL, `mutation($input: TransferIssueInput!) {
+  transferIssue(input: $input) {
+    issue { `+issueReferenceFields+` }
+  }
+}`, map[string]interface{}{
+		"input": map[string]interface{}{
+			"issueId":               issueID,
+			"repositoryId":          target.Repository.ID,
+			"createLabelsIfMissing": createLabels,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.TransferIssue.Issue, nil
+}
+
+// ClosingPullRequest is a pull request that closes an issue when it is merged
+type ClosingPullRequest struct {
+	Number     int    `json:"number"`
+	Title      string `json:"title"`
+	State      string `json:"state"`
+	IsDraft    bool   `json:"isDraft"`
+	URL        string `json:"url"`
+	Repository struct {
+		NameWithOwner string `json:"nameWithOwner"`
+	} `json:"repository"`
+	Author struct {
+		Login string `json:"login"`
+	} `json:"author"`
+}
+
+// ListClosingPullRequests lists the pull requests that close an issue when merged, through a
+// closing keyword ("Fixes #1") or a manual link. Closed, unmerged pull requests are only included
+// with includeClosed.
+func (i *IssueOperations) ListClosingPullRequests(ctx context.Context, owner, repo string, number int, includeClosed bool) ([]ClosingPullRequest, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+
+	result, err := GraphQLQuery[struct {
+		Repository struct {
+			Issue *struct {
+				ClosedByPullRequestsReferences struct {
+					Nodes []ClosingPullRequest `json:"nodes"`
+				} `json:"closedByPullRequestsReferences"`
+			} `json:"issue"`
+		} `json:"repository"`
+	}](ctx, i.client.GraphQL(), `query($owner: String!, $repo: String!, $number: Int!, $includeClosed: Boolean!) {
+  repository(owner: $owner, name: $repo) {
+    issue(number: $number) {
+      closedByPullRequestsReferences(first: 100, includeClosedPrs: $includeClosed) {
+        nodes {
+          number title state isDraft url
+          repository { nameWithOwner }
+          author { login }
+        }
+      }
+    }
+  }
+}`, map[string]interface{}{
+		"owner":         owner,
+		"repo":          repo,
+		"number":        number,
+		"includeClosed": includeClosed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Repository.Issue == nil {
+		return nil, errors.NewNotFoundError(fmt.Sprintf("issue #%d not found in %s/%s", number, owner, repo))
+	}
+
+	return result.Repository.Issue.ClosedByPullRequestsReferences.Nodes, nil
 }
@@ -0,0 +1,237 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestListIssueTimeline(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/octo/repo/issues/4/timeline" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{"event": "labeled", "created_at": "2025-03-01T10:00:00Z", "actor": {"login": "alice"}, "label": {"name": "bug"}},
+			{"event": "committed", "sha": "abc", "committer": {"date": "2025-03-01T09:00:00Z"}},
+			{"event": "reviewed", "submitted_at": "2025-03-01T11:00:00Z", "state": "approved"},
+			{"event": "mystery"},
+			{"event": "closed", "created_at": "2025-03-01T12:00:00Z", "actor": {"login": "bob"}}
+		]`))
+	})
+	issueOps := NewIssueOperations(client, logrus.New())
+
+	result, err := issueOps.ListIssueTimeline(context.Background(), "octo", "repo", 4, nil, PaginationOptions{})
+	if err != nil {
+		t.Fatalf("ListIssueTimeline() error = %v", err)
+	}
+	var order []string
+	for _, event := range result.Items {
+		order = append(order, event.GetEvent())
+	}
+	if got := strings.Join(order, ","); got != "labeled,committed,reviewed,mystery,closed" {
+		t.Errorf("order = %s, want the events in the order GitHub returned them", got)
+	}
+
+	result, err = issueOps.ListIssueTimeline(context.Background(), "octo", "repo", 4, []string{"closed", "labeled"}, PaginationOptions{})
+	if err != nil {
+		t.Fatalf("ListIssueTimeline() error = %v", err)
+	}
+	if len(result.Items) != 2 || result.Items[1].GetActor().GetLogin() != "bob" {
+		t.Errorf("filtered events = %v, want labeled and closed", result.Items)
+	}
+}
+
+func TestUpsertIssueComment(t *testing.T) {
+	comments := `[
+		{"id": 1, "body": "Build: red\n\n<!-- build-status -->", "user": {"login": "someone-else"}},
+		{"id": 2, "body": "Build: red\n\n<!-- build-status -->", "user": {"login": "bot"}}
+	]`
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/user":
+			w.Write([]byte(`{"login": "bot"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/octo/repo/issues/4/comments":
+			w.Write([]byte(comments))
+		case r.Method == http.MethodPatch && r.URL.Path == "/repos/octo/repo/issues/comments/2":
+			w.Write([]byte(`{"id": 2, "body": "Build: green\n\n<!-- build-status -->"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/octo/repo/issues/4/comments":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": 3, "body": "Build: green\n\n<!-- build-status -->"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	issueOps := NewIssueOperations(client, logrus.New())
+
+	// The marked comment of the authenticated user is updated, not the copy by someone else
+	result, err := issueOps.UpsertIssueComment(context.Background(), "octo", "repo", 4, "build-status", "Build: green")
+	if err != nil {
+		t.Fatalf("UpsertIssueComment() error = %v", err)
+	}
+	if result.Action != CommentUpsertUpdated || result.Comment.GetID() != 2 {
+		t.Errorf("result = %s %d, want comment 2 updated", result.Action, result.Comment.GetID())
+	}
+
+	// An identical body is left alone
+	requests = nil
+	comments = `[{"id": 2, "body": "Build: green\n\n<!-- build-status -->", "user": {"login": "bot"}}]`
+	result, err = issueOps.UpsertIssueComment(context.Background(), "octo", "repo", 4, "build-status", "Build: green")
+	if err != nil {
+		t.Fatalf("UpsertIssueComment() error = %v", err)
+	}
+	if result.Action != CommentUpsertUnchanged || len(requests) != 2 {
+		t.Errorf("result = %s after %v, want unchanged without writes", result.Action, requests)
+	}
+
+	// Without a marked comment by the authenticated user, one is created
+	comments = `[{"id": 1, "body": "Build: red\n\n<!-- build-status -->", "user": {"login": "someone-else"}}]`
+	result, err = issueOps.UpsertIssueComment(context.Background(), "octo", "repo", 4, "build-status", "Build: green")
+	if err != nil {
+		t.Fatalf("UpsertIssueComment() error = %v", err)
+	}
+	if result.Action != CommentUpsertCreated || result.Comment.GetID() != 3 {
+		t.Errorf("result = %s %d, want comment 3 created", result.Action, result.Comment.GetID())
+	}
+}
+
+func TestGetIssueSubIssuesSummary(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"number": 4, "title": "Epic", "sub_issues_summary": {"total": 4, "completed": 1, "percent_completed": 25}}`))
+	})
+	issueOps := NewIssueOperations(client, logrus.New())
+
+	issue, err := issueOps.GetIssue(context.Background(), "octo", "repo", 4)
+	if err != nil {
+		t.Fatalf("GetIssue() error = %v", err)
+	}
+	if issue.GetTitle() != "Epic" || issue.SubIssuesSummary == nil || issue.SubIssuesSummary.PercentCompleted != 25 {
+		t.Errorf("issue = %+v, summary = %+v, want the title and the sub-issue rollup", issue.Issue, issue.SubIssuesSummary)
+	}
+}
+
+func TestAddSubIssue(t *testing.T) {
+	var mutationInput map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(req.Query, "addSubIssue"):
+			mutationInput = req.Variables["input"].(map[string]interface{})
+			w.Write([]byte(`{"data": {"addSubIssue": {"issue": {"id": "I_4"}}}}`))
+		case strings.Contains(req.Query, "subIssues(first"):
+			w.Write([]byte(`{"data": {"repository": {"issue": {
+				"number": 4, "title": "Epic", "state": "OPEN", "repository": {"nameWithOwner": "octo/repo"},
+				"subIssuesSummary": {"total": 2, "completed": 1, "percent_completed": 50},
+				"parent": null,
+				"subIssues": {"totalCount": 2, "nodes": [
+					{"number": 5, "title": "Done", "state": "CLOSED", "stateReason": "COMPLETED", "repository": {"nameWithOwner": "octo/repo"}},
+					{"number": 9, "title": "Other repo", "state": "OPEN", "repository": {"nameWithOwner": "octo/other"}}
+				]}
+			}}}}`))
+		case req.Variables["repo"] == "other":
+			w.Write([]byte(`{"data": {"repository": {"issue": {"id": "I_9"}}}}`))
+		default:
+			w.Write([]byte(`{"data": {"repository": {"issue": {"id": "I_4"}}}}`))
+		}
+	})
+	issueOps := NewIssueOperations(client, logrus.New())
+
+	hierarchy, err := issueOps.AddSubIssue(context.Background(), "octo", "repo", 4, "", "other", 9, true)
+	if err != nil {
+		t.Fatalf("AddSubIssue() error = %v", err)
+	}
+	if mutationInput["issueId"] != "I_4" || mutationInput["subIssueId"] != "I_9" || mutationInput["replaceParent"] != true {
+		t.Errorf("mutation input = %v, want I_9 added to I_4 replacing its parent", mutationInput)
+	}
+	if hierarchy.Issue.SubIssuesSummary.PercentCompleted != 50 || len(hierarchy.SubIssues) != 2 || hierarchy.SubIssues[1].Repository.NameWithOwner != "octo/other" {
+		t.Errorf("hierarchy = %+v, want the parent with both sub-issues", hierarchy)
+	}
+}
+
+func TestUpdateIssueStateReason(t *testing.T) {
+	var edits []map[string]interface{}
+	var mutationInput map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/graphql":
+			var req graphQLRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request: %v", err)
+			}
+			if strings.Contains(req.Query, "closeIssue") {
+				mutationInput = req.Variables["input"].(map[string]interface{})
+				if mutationInput["duplicateIssueId"] == "I_404" {
+					w.Write([]byte(`{"errors": [{"type": "NOT_FOUND", "message": "Could not resolve to an Issue"}]}`))
+					return
+				}
+				w.Write([]byte(`{"data": {"closeIssue": {"issue": {"id": "I_7"}}}}`))
+				return
+			}
+			w.Write([]byte(fmt.Sprintf(`{"data": {"repository": {"issue": {"id": "I_%v"}}}}`, req.Variables["number"])))
+		case r.Method == http.MethodPatch && r.URL.Path == "/repos/octo/repo/issues/7":
+			var edit map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&edit); err != nil {
+				t.Fatalf("decoding request: %v", err)
+			}
+			edits = append(edits, edit)
+			w.Write([]byte(`{"number": 7, "state": "open"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/octo/repo/issues/7":
+			w.Write([]byte(`{"number": 7, "state": "closed", "state_reason": "duplicate"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	issueOps := NewIssueOperations(client, logrus.New())
+
+	// The reason implies the state
+	if _, err := issueOps.UpdateIssue(context.Background(), "octo", "repo", 7, "", "", "", StateReasonNotPlanned, 0, nil, nil, 0); err != nil {
+		t.Fatalf("UpdateIssue() error = %v", err)
+	}
+	if edits[0]["state"] != "closed" || edits[0]["state_reason"] != "not_planned" {
+		t.Errorf("edit = %v, want closed as not_planned", edits[0])
+	}
+
+	// Duplicates are closed through GraphQL, linking the original
+	issue, err := issueOps.UpdateIssue(context.Background(), "octo", "repo", 7, "", "", "", "", 3, nil, nil, 0)
+	if err != nil {
+		t.Fatalf("UpdateIssue() error = %v", err)
+	}
+	if mutationInput["issueId"] != "I_7" || mutationInput["duplicateIssueId"] != "I_3" || mutationInput["stateReason"] != "DUPLICATE" {
+		t.Errorf("mutation input = %v, want I_7 closed as duplicate of I_3", mutationInput)
+	}
+	if len(edits) != 1 || issue.GetStateReason() != "duplicate" {
+		t.Errorf("edits = %v, issue = %v; want no REST edit and the closed issue", edits, issue)
+	}
+
+	// Other changes are applied before closing, and reported if the close fails
+	_, err = issueOps.UpdateIssue(context.Background(), "octo", "repo", 7, "Renamed", "", "", "", 404, nil, nil, 0)
+	if err == nil || !strings.Contains(err.Error(), "issue #7 was updated, but closing it as a duplicate of #404 failed") {
+		t.Errorf("error = %v, want the applied edit to be reported", err)
+	}
+	if len(edits) != 2 || edits[1]["title"] != "Renamed" {
+		t.Errorf("edits = %v, want the title edit before the close", edits)
+	}
+
+	// Conflicting state and reason are refused
+	if _, err := issueOps.UpdateIssue(context.Background(), "octo", "repo", 7, "", "", "open", StateReasonCompleted, 0, nil, nil, 0); err == nil {
+		t.Error("UpdateIssue() error = nil, want refusal of state open with reason completed")
+	}
+	if _, err := issueOps.UpdateIssue(context.Background(), "octo", "repo", 7, "", "", "", StateReasonDuplicate, 0, nil, nil, 0); err == nil {
+		t.Error("UpdateIssue() error = nil, want duplicate_of to be required")
+	}
+}
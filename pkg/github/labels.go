@@ -0,0 +1,397 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+)
+
+// colorPattern matches a label color without the leading "#"
+var colorPattern = regexp.MustCompile(`^[0-9a-fA-F]{6}$`)
+
+// LabelOperations handles label-related operations
+type LabelOperations struct {
+	client *Client
+	logger *logrus.Logger
+}
+
+// NewLabelOperations creates a new LabelOperations
+func NewLabelOperations(client *Client, logger *logrus.Logger) *LabelOperations {
+	return &LabelOperations{
+		client: client,
+		logger: logger,
+	}
+}
+
+// normalizeColor strips a leading "#" and lowercases a label color
+func normalizeColor(color string) (string, error) {
+	color = strings.ToLower(strings.TrimPrefix(color, "#"))
+	if !colorPattern.MatchString(color) {
+		return "", errors.NewValidationError(fmt.Sprintf("color %q must be a 6-digit hex code like d73a4a", color))
+	}
+	return color, nil
+}
+
+// ListLabels lists the labels of a repository
+func (l *LabelOperations) ListLabels(ctx context.Context, owner, repo string, pagination PaginationOptions) (*ListResult[*github.Label], error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+
+	// List labels
+	result, err := Paginate(ctx, pagination, 30, func(listOpts github.ListOptions) ([]*github.Label, *github.Response, error) {
+		return l.client.GetClient().Issues.ListLabels(ctx, owner, repo, &listOpts)
+	})
+	if err != nil {
+		return nil, l.client.HandleError(err)
+	}
+
+	return result, nil
+}
+
+// CreateLabel creates a label
+func (l *LabelOperations) CreateLabel(ctx context.Context, owner, repo, name, color, description string) (*github.Label, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if name == "" {
+		return nil, errors.NewValidationError("name cannot be empty")
+	}
+	color, err := normalizeColor(color)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create label
+	label, _, err := l.client.GetClient().Issues.CreateLabel(ctx, owner, repo, &github.Label{
+		Name:        github.String(name),
+		Color:       github.String(color),
+		Description: github.String(description),
+	})
+	if err != nil {
+		return nil, l.client.HandleError(err)
+	}
+
+	return label, nil
+}
+
+// LabelUpdate holds the changes to a label; nil fields are left unchanged
+type LabelUpdate struct {
+	NewName     *string
+	Color       *string
+	Description *string
+}
+
+// UpdateLabel renames a label or changes its color or description.
+// Renaming keeps the label on all issues and pull requests that have it.
+func (l *LabelOperations) UpdateLabel(ctx context.Context, owner, repo, name string, update LabelUpdate) (*github.Label, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if name == "" {
+		return nil, errors.NewValidationError("name cannot be empty")
+	}
+	if update.NewName == nil && update.Color == nil && update.Description == nil {
+		return nil, errors.NewValidationError("at least one of new_name, color or description must be provided")
+	}
+	if update.NewName != nil && *update.NewName == "" {
+		return nil, errors.NewValidationError("new_name cannot be empty")
+	}
+
+	label := &github.Label{
+		Name:        update.NewName,
+		Description: update.Description,
+	}
+	if update.Color != nil {
+		color, err := normalizeColor(*update.Color)
+		if err != nil {
+			return nil, err
+		}
+		label.Color = github.String(color)
+	}
+
+	// Update label
+	result, _, err := l.client.GetClient().Issues.EditLabel(ctx, owner, repo, name, label)
+	if err != nil {
+		return nil, l.client.HandleError(err)
+	}
+
+	return result, nil
+}
+
+// DeleteLabel deletes a label, removing it from all issues and pull requests
+func (l *LabelOperations) DeleteLabel(ctx context.Context, owner, repo, name string) error {
+	// Validate parameters
+	if owner == "" {
+		return errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return errors.NewValidationError("repo cannot be empty")
+	}
+	if name == "" {
+		return errors.NewValidationError("name cannot be empty")
+	}
+
+	// Delete label
+	_, err := l.client.GetClient().Issues.DeleteLabel(ctx, owner, repo, name)
+	if err != nil {
+		return l.client.HandleError(err)
+	}
+
+	return nil
+}
+
+// LabelDefinition is the desired state of a label in a labels-as-code file
+type LabelDefinition struct {
+	Name        string `yaml:"name" json:"name"`
+	Color       string `yaml:"color" json:"color"`
+	Description string `yaml:"description" json:"description"`
+	// Aliases are former names; an existing label with one of them is renamed instead of recreated
+	Aliases []string `yaml:"aliases" json:"aliases"`
+}
+
+// ParseLabelDefinitions parses a YAML or JSON labels file, which is either a list of labels or an
+// object with a "labels" list. Names must be unique, case-insensitively and including aliases.
+func ParseLabelDefinitions(content string) ([]LabelDefinition, error) {
+	// JSON is valid YAML, so one parser handles both formats
+	var definitions []LabelDefinition
+	if err := yaml.Unmarshal([]byte(content), &definitions); err != nil {
+		var wrapped struct {
+			Labels []LabelDefinition `yaml:"labels"`
+		}
+		if wrappedErr := yaml.Unmarshal([]byte(content), &wrapped); wrappedErr != nil {
+			return nil, errors.NewValidationError(fmt.Sprintf("labels file is neither a list of labels nor an object with a labels list: %v", err))
+		}
+		definitions = wrapped.Labels
+	}
+	if len(definitions) == 0 {
+		return nil, errors.NewValidationError("labels file defines no labels")
+	}
+
+	seen := make(map[string]bool)
+	for i := range definitions {
+		definition := &definitions[i]
+		if definition.Name == "" {
+			return nil, errors.NewValidationError(fmt.Sprintf("label %d has no name", i+1))
+		}
+		color := strings.ToLower(strings.TrimPrefix(definition.Color, "#"))
+		if !colorPattern.MatchString(color) {
+			return nil, errors.NewValidationError(fmt.Sprintf("label %q: color %q must be a 6-digit hex code like d73a4a", definition.Name, definition.Color))
+		}
+		definition.Color = color
+
+		for _, name := range append([]string{definition.Name}, definition.Aliases...) {
+			key := strings.ToLower(name)
+			if seen[key] {
+				return nil, errors.NewValidationError(fmt.Sprintf("label name %q is defined more than once", name))
+			}
+			seen[key] = true
+		}
+	}
+
+	return definitions, nil
+}
+
+// Label sync actions
+const (
+	LabelActionCreate = "create"
+	LabelActionUpdate = "update"
+	LabelActionDelete = "delete"
+)
+
+// LabelChange is a change needed to bring a repository's labels in line with the definitions
+type LabelChange struct {
+	Action string
+	// Name is the label's current name; NewName is set if an update renames it
+	Name           string
+	NewName        string
+	Color          string
+	OldColor       string
+	Description    string
+	OldDescription string
+	// Error is set if applying the change failed
+	Error string
+}
+
+// LabelSyncResult is the outcome of a label sync
+type LabelSyncResult struct {
+	DryRun  bool
+	Changes []LabelChange
+	// Unchanged counts labels that already match their definition
+	Unchanged int
+	// Unmanaged lists existing labels without a definition that are kept because prune is off
+	Unmanaged []string
+}
+
+// LabelSyncSource is the location of a labels file
+type LabelSyncSource struct {
+	Owner string
+	Repo  string
+	Path  string
+	Ref   string
+}
+
+// SyncLabels reconciles the labels of a repository with the definitions in a YAML or JSON file.
+// Labels without a definition are deleted only if prune is set. With dryRun, the changes are
+// computed but not applied. Failing changes are reported per label and do not stop the sync.
+func (l *LabelOperations) SyncLabels(ctx context.Context, owner, repo string, source LabelSyncSource, prune, dryRun bool) (*LabelSyncResult, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if source.Path == "" {
+		return nil, errors.NewValidationError("path cannot be empty")
+	}
+	if source.Owner == "" {
+		source.Owner = owner
+	}
+	if source.Repo == "" {
+		source.Repo = repo
+	}
+
+	// Read the definitions
+	fileOps := NewFileOperations(l.client, l.logger)
+	contents, err := fileOps.GetFileContents(ctx, source.Owner, source.Repo, source.Path, source.Ref)
+	if err != nil {
+		return nil, err
+	}
+	file, ok := contents.(*github.RepositoryContent)
+	if !ok {
+		return nil, errors.NewValidationError(fmt.Sprintf("%s is a directory, not a labels file", source.Path))
+	}
+	content, err := fileOps.DecodeFileContent(file)
+	if err != nil {
+		return nil, err
+	}
+	definitions, err := ParseLabelDefinitions(content)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := l.ListLabels(ctx, owner, repo, PaginationOptions{PerPage: MaxPerPage, MaxItems: MaxItemsLimit})
+	if err != nil {
+		return nil, err
+	}
+	if existing.Truncated {
+		return nil, errors.NewValidationError(fmt.Sprintf("%s/%s has more than %d labels, which is more than sync_labels supports", owner, repo, MaxItemsLimit))
+	}
+
+	result := planLabelSync(definitions, existing.Items, prune)
+	result.DryRun = dryRun
+	if dryRun {
+		return result, nil
+	}
+
+	// Apply the changes
+	for i := range result.Changes {
+		change := &result.Changes[i]
+		var err error
+		switch change.Action {
+		case LabelActionCreate:
+			_, err = l.CreateLabel(ctx, owner, repo, change.Name, change.Color, change.Description)
+		case LabelActionUpdate:
+			update := LabelUpdate{Color: github.String(change.Color), Description: github.String(change.Description)}
+			if change.NewName != "" {
+				update.NewName = github.String(change.NewName)
+			}
+			_, err = l.UpdateLabel(ctx, owner, repo, change.Name, update)
+		case LabelActionDelete:
+			err = l.DeleteLabel(ctx, owner, repo, change.Name)
+		}
+		if err != nil {
+			change.Error = err.Error()
+		}
+	}
+
+	return result, nil
+}
+
+// planLabelSync computes the changes that turn the existing labels into the defined ones
+func planLabelSync(definitions []LabelDefinition, existing []*github.Label, prune bool) *LabelSyncResult {
+	byName := make(map[string]*github.Label, len(existing))
+	for _, label := range existing {
+		byName[strings.ToLower(label.GetName())] = label
+	}
+
+	result := &LabelSyncResult{}
+	matched := make(map[*github.Label]bool)
+	for _, definition := range definitions {
+		// Match by name first, then by alias to rename the label
+		label := byName[strings.ToLower(definition.Name)]
+		for _, alias := range definition.Aliases {
+			if label != nil {
+				break
+			}
+			label = byName[strings.ToLower(alias)]
+		}
+
+		if label == nil {
+			result.Changes = append(result.Changes, LabelChange{
+				Action:      LabelActionCreate,
+				Name:        definition.Name,
+				Color:       definition.Color,
+				Description: definition.Description,
+			})
+			continue
+		}
+		matched[label] = true
+
+		change := LabelChange{
+			Action:         LabelActionUpdate,
+			Name:           label.GetName(),
+			Color:          definition.Color,
+			OldColor:       label.GetColor(),
+			Description:    definition.Description,
+			OldDescription: label.GetDescription(),
+		}
+		if label.GetName() != definition.Name {
+			// Also fixes the capitalization of a name
+			change.NewName = definition.Name
+		}
+		if change.NewName == "" && strings.EqualFold(change.Color, change.OldColor) && change.Description == change.OldDescription {
+			result.Unchanged++
+			continue
+		}
+		result.Changes = append(result.Changes, change)
+	}
+
+	for _, label := range existing {
+		if matched[label] {
+			continue
+		}
+		if !prune {
+			result.Unmanaged = append(result.Unmanaged, label.GetName())
+			continue
+		}
+		result.Changes = append(result.Changes, LabelChange{
+			Action:         LabelActionDelete,
+			Name:           label.GetName(),
+			OldColor:       label.GetColor(),
+			OldDescription: label.GetDescription(),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,151 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/sirupsen/logrus"
+)
+
+func TestParseLabelDefinitions(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		want    []LabelDefinition
+		wantErr string
+	}{
+		{
+			name: "YAMLList",
+			content: `- name: bug
+  color: "#D73A4A"
+  description: Something isn't working
+  aliases: [defect]
+- name: chore
+  color: 000000
+`,
+			want: []LabelDefinition{
+				{Name: "bug", Color: "d73a4a", Description: "Something isn't working", Aliases: []string{"defect"}},
+				{Name: "chore", Color: "000000"},
+			},
+		},
+		{
+			name:    "JSONObject",
+			content: `{"labels": [{"name": "bug", "color": "d73a4a"}]}`,
+			want:    []LabelDefinition{{Name: "bug", Color: "d73a4a"}},
+		},
+		{name: "Empty", content: `labels: []`, wantErr: "defines no labels"},
+		{name: "MissingName", content: `[{"color": "d73a4a"}]`, wantErr: "label 1 has no name"},
+		{name: "InvalidColor", content: `[{"name": "bug", "color": "red"}]`, wantErr: "6-digit hex code"},
+		{name: "DuplicateAlias", content: `[{"name": "bug", "color": "d73a4a"}, {"name": "defect", "color": "d73a4a", "aliases": ["Bug"]}]`, wantErr: "defined more than once"},
+		{name: "MappingWithoutLabels", content: `name: bug`, wantErr: "defines no labels"},
+		{name: "NotALabelsFile", content: `just some text`, wantErr: "neither a list"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseLabelDefinitions(tc.content)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("error = %v, want one containing %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseLabelDefinitions() error = %v", err)
+			}
+			gotJSON, _ := json.Marshal(got)
+			wantJSON, _ := json.Marshal(tc.want)
+			if string(gotJSON) != string(wantJSON) {
+				t.Errorf("definitions = %s, want %s", gotJSON, wantJSON)
+			}
+		})
+	}
+}
+
+func TestPlanLabelSync(t *testing.T) {
+	label := func(name, color, description string) *github.Label {
+		return &github.Label{Name: github.String(name), Color: github.String(color), Description: github.String(description)}
+	}
+	definitions := []LabelDefinition{
+		{Name: "bug", Color: "d73a4a", Description: "Broken"},
+		{Name: "enhancement", Color: "a2eeef", Aliases: []string{"feature"}},
+		{Name: "docs", Color: "0075ca"},
+		{Name: "Question", Color: "d876e3"},
+	}
+	existing := []*github.Label{
+		label("bug", "D73A4A", "Broken"),
+		label("feature", "a2eeef", ""),
+		label("question", "d876e3", ""),
+		label("wontfix", "ffffff", ""),
+	}
+
+	result := planLabelSync(definitions, existing, false)
+	var summary []string
+	for _, change := range result.Changes {
+		summary = append(summary, change.Action+":"+change.Name+">"+change.NewName)
+	}
+	if got := strings.Join(summary, ","); got != "update:feature>enhancement,create:docs>,update:question>Question" {
+		t.Errorf("changes = %s", got)
+	}
+	if result.Unchanged != 1 || len(result.Unmanaged) != 1 || result.Unmanaged[0] != "wontfix" {
+		t.Errorf("Unchanged = %d, Unmanaged = %v, want 1 and [wontfix]", result.Unchanged, result.Unmanaged)
+	}
+
+	pruned := planLabelSync(definitions, existing, true)
+	last := pruned.Changes[len(pruned.Changes)-1]
+	if last.Action != LabelActionDelete || last.Name != "wontfix" || len(pruned.Unmanaged) != 0 {
+		t.Errorf("changes = %+v, want wontfix to be deleted when pruning", pruned.Changes)
+	}
+}
+
+func TestSyncLabels(t *testing.T) {
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/repos/octo/config/contents/labels.yml":
+			content := base64.StdEncoding.EncodeToString([]byte("- name: bug\n  color: d73a4a\n- name: docs\n  color: 0075ca\n"))
+			w.Write([]byte(`{"type": "file", "encoding": "base64", "content": "` + content + `"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/octo/repo/labels":
+			w.Write([]byte(`[{"name": "bug", "color": "ff0000"}, {"name": "old", "color": "ffffff"}]`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/repos/octo/repo/labels/bug":
+			w.Write([]byte(`{"name": "bug", "color": "d73a4a"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/octo/repo/labels":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"message": "Validation Failed"}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/repos/octo/repo/labels/old":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	labelOps := NewLabelOperations(client, logrus.New())
+	source := LabelSyncSource{Owner: "octo", Repo: "config", Path: "labels.yml"}
+
+	// A dry run only reads
+	result, err := labelOps.SyncLabels(context.Background(), "octo", "repo", source, true, true)
+	if err != nil {
+		t.Fatalf("SyncLabels() error = %v", err)
+	}
+	if len(result.Changes) != 3 || len(requests) != 2 {
+		t.Fatalf("changes = %+v, requests = %v, want 3 planned changes and only reads", result.Changes, requests)
+	}
+
+	// Failing changes are reported and do not stop the sync
+	result, err = labelOps.SyncLabels(context.Background(), "octo", "repo", source, true, false)
+	if err != nil {
+		t.Fatalf("SyncLabels() error = %v", err)
+	}
+	if result.Changes[0].Error != "" || result.Changes[1].Error == "" || result.Changes[2].Error != "" {
+		t.Errorf("changes = %+v, want only the create to fail", result.Changes)
+	}
+	if requests[len(requests)-1] != "DELETE /repos/octo/repo/labels/old" {
+		t.Errorf("requests = %v, want the delete after the failed create", requests)
+	}
+}
@@ -0,0 +1,158 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+)
+
+// lfsPointerVersion is the first line of every Git LFS pointer file
+const lfsPointerVersion = "version https://git-lfs.github.com/spec/v1"
+
+// MaxLFSObjectSize is the largest LFS object DownloadLFSObject fetches
+const MaxLFSObjectSize = 10 * 1024 * 1024
+
+// LFSPointer is the content of a Git LFS pointer file
+type LFSPointer struct {
+	// OID is the SHA-256 of the object
+	OID  string
+	Size int64
+}
+
+// ParseLFSPointer parses content as a Git LFS pointer file
+func ParseLFSPointer(content []byte) (*LFSPointer, bool) {
+	// Pointer files are small; the spec limits them to 1024 bytes
+	if len(content) > 1024 || !bytes.HasPrefix(content, []byte(lfsPointerVersion+"\n")) {
+		return nil, false
+	}
+
+	pointer := &LFSPointer{Size: -1}
+	for _, line := range strings.Split(string(content), "\n") {
+		key, value, _ := strings.Cut(line, " ")
+		switch key {
+		case "oid":
+			pointer.OID = strings.TrimPrefix(value, "sha256:")
+		case "size":
+			size, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, false
+			}
+			pointer.Size = size
+		}
+	}
+	if pointer.OID == "" || pointer.Size < 0 {
+		return nil, false
+	}
+
+	return pointer, true
+}
+
+// lfsBatchRequest is a request to the Git LFS batch API
+type lfsBatchRequest struct {
+	Operation string           `json:"operation"`
+	Transfers []string         `json:"transfers"`
+	Objects   []lfsBatchObject `json:"objects"`
+}
+
+// lfsBatchObject is an object in a Git LFS batch request or response
+type lfsBatchObject struct {
+	OID     string `json:"oid"`
+	Size    int64  `json:"size"`
+	Actions *struct {
+		Download *struct {
+			Href   string            `json:"href"`
+			Header map[string]string `json:"header"`
+		} `json:"download"`
+	} `json:"actions,omitempty"`
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// lfsBatchResponse is a response of the Git LFS batch API
+type lfsBatchResponse struct {
+	Objects []lfsBatchObject `json:"objects"`
+}
+
+// DownloadLFSObject fetches the object a Git LFS pointer points to, using the LFS batch API of
+// the repository. Objects larger than MaxLFSObjectSize are refused.
+func (f *FileOperations) DownloadLFSObject(ctx context.Context, owner, repo string, pointer *LFSPointer) ([]byte, error) {
+	if pointer.Size > MaxLFSObjectSize {
+		return nil, errors.NewValidationError(fmt.Sprintf("the LFS object is %d bytes, more than the %d bytes that can be fetched", pointer.Size, MaxLFSObjectSize))
+	}
+
+	// Ask the batch API where to download the object; it is served by the web host, not the API host
+	client := f.client.GetClient()
+	batchURL := fmt.Sprintf("%s%s/%s.git/info/lfs/objects/batch", webBaseURL(client.BaseURL.String()), owner, repo)
+	req, err := client.NewRequest(http.MethodPost, batchURL, &lfsBatchRequest{
+		Operation: "download",
+		Transfers: []string{"basic"},
+		Objects:   []lfsBatchObject{{OID: pointer.OID, Size: pointer.Size}},
+	})
+	if err != nil {
+		return nil, errors.NewInternalError("failed to create LFS batch request: " + err.Error())
+	}
+	req.Header.Set("Accept", "application/vnd.git-lfs+json")
+	req.Header.Set("Content-Type", "application/vnd.git-lfs+json")
+
+	var batch lfsBatchResponse
+	if _, err := client.Do(ctx, req, &batch); err != nil {
+		return nil, f.client.HandleError(err)
+	}
+	if len(batch.Objects) == 0 {
+		return nil, errors.NewInternalError("the LFS batch API returned no objects")
+	}
+	object := batch.Objects[0]
+	if object.Error != nil {
+		if object.Error.Code == http.StatusNotFound {
+			return nil, errors.NewNotFoundError(fmt.Sprintf("LFS object %s not found: %s", pointer.OID, object.Error.Message))
+		}
+		return nil, errors.NewInternalError(fmt.Sprintf("LFS object %s: %s", pointer.OID, object.Error.Message))
+	}
+	if object.Actions == nil || object.Actions.Download == nil {
+		return nil, errors.NewInternalError(fmt.Sprintf("the LFS batch API returned no download for object %s", pointer.OID))
+	}
+
+	// Download the object; the href is pre-authorized, so the GitHub token is not sent along
+	download, err := http.NewRequestWithContext(ctx, http.MethodGet, object.Actions.Download.Href, nil)
+	if err != nil {
+		return nil, errors.NewInternalError("failed to create LFS download request: " + err.Error())
+	}
+	for name, value := range object.Actions.Download.Header {
+		download.Header.Set(name, value)
+	}
+	resp, err := f.client.GetHTTPClient().Do(download)
+	if err != nil {
+		return nil, errors.NewInternalError("failed to download LFS object: " + err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.NewInternalError(fmt.Sprintf("failed to download LFS object: %s", resp.Status))
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, MaxLFSObjectSize+1))
+	if err != nil {
+		return nil, errors.NewInternalError("failed to download LFS object: " + err.Error())
+	}
+	if len(content) > MaxLFSObjectSize {
+		return nil, errors.NewValidationError(fmt.Sprintf("the LFS object is larger than the %d bytes that can be fetched", MaxLFSObjectSize))
+	}
+
+	return content, nil
+}
+
+// webBaseURL derives the web URL of a GitHub instance from its API URL
+func webBaseURL(apiURL string) string {
+	if apiURL == "https://api.github.com/" {
+		return "https://github.com/"
+	}
+	// GitHub Enterprise Server serves the API below /api/v3/
+	return strings.TrimSuffix(apiURL, "api/v3/")
+}
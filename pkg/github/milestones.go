@@ -0,0 +1,219 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/sirupsen/logrus"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+)
+
+// MilestoneOperations handles milestone-related operations
+type MilestoneOperations struct {
+	client *Client
+	logger *logrus.Logger
+}
+
+// NewMilestoneOperations creates a new MilestoneOperations
+func NewMilestoneOperations(client *Client, logger *logrus.Logger) *MilestoneOperations {
+	return &MilestoneOperations{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ListMilestones lists the milestones of a repository
+func (m *MilestoneOperations) ListMilestones(ctx context.Context, owner, repo, state, sort, direction string, pagination PaginationOptions) (*ListResult[*github.Milestone], error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	switch state {
+	case "", "open", "closed", "all":
+	default:
+		return nil, errors.NewValidationError("state must be one of: open, closed, all")
+	}
+	switch sort {
+	case "", "due_on", "completeness":
+	default:
+		return nil, errors.NewValidationError("sort must be either due_on or completeness")
+	}
+	switch direction {
+	case "", "asc", "desc":
+	default:
+		return nil, errors.NewValidationError("direction must be either asc or desc")
+	}
+
+	// Set up options
+	opts := &github.MilestoneListOptions{
+		State:     state,
+		Sort:      sort,
+		Direction: direction,
+	}
+
+	// List milestones
+	result, err := Paginate(ctx, pagination, 30, func(listOpts github.ListOptions) ([]*github.Milestone, *github.Response, error) {
+		opts.ListOptions = listOpts
+		return m.client.GetClient().Issues.ListMilestones(ctx, owner, repo, opts)
+	})
+	if err != nil {
+		return nil, m.client.HandleError(err)
+	}
+
+	return result, nil
+}
+
+// GetMilestone gets a milestone by number
+func (m *MilestoneOperations) GetMilestone(ctx context.Context, owner, repo string, number int) (*github.Milestone, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+
+	// Get milestone
+	milestone, _, err := m.client.GetClient().Issues.GetMilestone(ctx, owner, repo, number)
+	if err != nil {
+		return nil, m.client.HandleError(err)
+	}
+
+	return milestone, nil
+}
+
+// CreateMilestone creates a milestone; dueOn is optional
+func (m *MilestoneOperations) CreateMilestone(ctx context.Context, owner, repo, title, description string, dueOn *time.Time) (*github.Milestone, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if title == "" {
+		return nil, errors.NewValidationError("title cannot be empty")
+	}
+
+	milestone := &github.Milestone{
+		Title: github.String(title),
+	}
+	if description != "" {
+		milestone.Description = github.String(description)
+	}
+	if dueOn != nil {
+		milestone.DueOn = &github.Timestamp{Time: *dueOn}
+	}
+
+	// Create milestone
+	result, _, err := m.client.GetClient().Issues.CreateMilestone(ctx, owner, repo, milestone)
+	if err != nil {
+		return nil, m.client.HandleError(err)
+	}
+
+	return result, nil
+}
+
+// MilestoneUpdate holds the changes to a milestone; nil fields are left unchanged
+type MilestoneUpdate struct {
+	Title       *string
+	Description *string
+	// State is open or closed
+	State *string
+	DueOn *time.Time
+}
+
+// UpdateMilestone changes the title, description, state or due date of a milestone
+func (m *MilestoneOperations) UpdateMilestone(ctx context.Context, owner, repo string, number int, update MilestoneUpdate) (*github.Milestone, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+	if update.Title == nil && update.Description == nil && update.State == nil && update.DueOn == nil {
+		return nil, errors.NewValidationError("at least one of title, description, state or due_on must be provided")
+	}
+	if update.Title != nil && *update.Title == "" {
+		return nil, errors.NewValidationError("title cannot be empty")
+	}
+	if update.State != nil && *update.State != "open" && *update.State != "closed" {
+		return nil, errors.NewValidationError("state must be either open or closed")
+	}
+
+	milestone := &github.Milestone{
+		Title:       update.Title,
+		Description: update.Description,
+		State:       update.State,
+	}
+	if update.DueOn != nil {
+		milestone.DueOn = &github.Timestamp{Time: *update.DueOn}
+	}
+
+	// Update milestone
+	result, _, err := m.client.GetClient().Issues.EditMilestone(ctx, owner, repo, number, milestone)
+	if err != nil {
+		return nil, m.client.HandleError(err)
+	}
+
+	return result, nil
+}
+
+// maxMilestoneSuggestions caps the titles listed when a milestone is not found
+const maxMilestoneSuggestions = 20
+
+// ResolveMilestone turns a milestone filter into the value the issues API expects.
+// "none" and "*" are passed through. A number is used if such a milestone exists; anything else,
+// including a number without a milestone, is looked up as a title, case-insensitively and among
+// open and closed milestones.
+func (m *MilestoneOperations) ResolveMilestone(ctx context.Context, owner, repo, milestone string) (string, error) {
+	if milestone == "" || milestone == "none" || milestone == "*" {
+		return milestone, nil
+	}
+	if number, err := strconv.Atoi(milestone); err == nil && number > 0 {
+		// Titles like "2025" are common, so a number without a milestone may still be a title
+		_, err := m.GetMilestone(ctx, owner, repo, number)
+		if err == nil {
+			return milestone, nil
+		}
+		if !m.client.IsNotFound(err) {
+			return "", err
+		}
+	}
+
+	milestones, err := m.ListMilestones(ctx, owner, repo, "all", "", "", PaginationOptions{PerPage: MaxPerPage, MaxItems: MaxItemsLimit})
+	if err != nil {
+		return "", err
+	}
+
+	var titles []string
+	for _, candidate := range milestones.Items {
+		if strings.EqualFold(candidate.GetTitle(), milestone) {
+			return strconv.Itoa(candidate.GetNumber()), nil
+		}
+		titles = append(titles, candidate.GetTitle())
+	}
+
+	message := fmt.Sprintf("milestone %q not found in %s/%s", milestone, owner, repo)
+	if len(titles) > maxMilestoneSuggestions {
+		message += fmt.Sprintf(" (available: %s and %d more)", strings.Join(titles[:maxMilestoneSuggestions], ", "), len(titles)-maxMilestoneSuggestions)
+	} else if len(titles) > 0 {
+		message += fmt.Sprintf(" (available: %s)", strings.Join(titles, ", "))
+	}
+	return "", errors.NewNotFoundError(message)
+}
@@ -0,0 +1,82 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestResolveMilestone(t *testing.T) {
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/repos/octo/repo/milestones/3":
+			w.Write([]byte(`{"number": 3, "title": "v1.0", "state": "closed"}`))
+		case "/repos/octo/repo/milestones/2025", "/repos/octo/repo/milestones/12":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "Not Found"}`))
+		case "/repos/octo/repo/milestones":
+			if r.URL.Query().Get("state") != "all" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			}
+			w.Write([]byte(`[{"number": 3, "title": "v1.0", "state": "closed"}, {"number": 7, "title": "v2.0", "state": "open"}, {"number": 9, "title": "2025", "state": "open"}]`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	})
+	milestoneOps := NewMilestoneOperations(client, logrus.New())
+
+	for _, passThrough := range []string{"", "none", "*"} {
+		got, err := milestoneOps.ResolveMilestone(context.Background(), "octo", "repo", passThrough)
+		if err != nil || got != passThrough {
+			t.Errorf("ResolveMilestone(%q) = %q, %v, want it unchanged", passThrough, got, err)
+		}
+	}
+	if len(requests) != 0 {
+		t.Fatalf("requests = %v, want wildcards to be passed through without a lookup", requests)
+	}
+
+	tests := []struct {
+		milestone string
+		want      string
+	}{
+		{milestone: "3", want: "3"},
+		{milestone: "V1.0", want: "3"},
+		// No milestone #2025, but one titled 2025
+		{milestone: "2025", want: "9"},
+	}
+	for _, tt := range tests {
+		got, err := milestoneOps.ResolveMilestone(context.Background(), "octo", "repo", tt.milestone)
+		if err != nil || got != tt.want {
+			t.Errorf("ResolveMilestone(%q) = %q, %v, want %s", tt.milestone, got, err, tt.want)
+		}
+	}
+
+	_, err := milestoneOps.ResolveMilestone(context.Background(), "octo", "repo", "12")
+	if err == nil || !strings.Contains(err.Error(), "available: v1.0, v2.0, 2025") {
+		t.Errorf("error = %v, want a not found error listing the available milestones", err)
+	}
+}
+
+func TestResolveMilestoneCapsSuggestions(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var milestones []string
+		for i := 1; i <= maxMilestoneSuggestions+5; i++ {
+			milestones = append(milestones, fmt.Sprintf(`{"number": %d, "title": "sprint %d"}`, i, i))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("[" + strings.Join(milestones, ",") + "]"))
+	})
+	milestoneOps := NewMilestoneOperations(client, logrus.New())
+
+	_, err := milestoneOps.ResolveMilestone(context.Background(), "octo", "repo", "backlog")
+	if err == nil || !strings.Contains(err.Error(), "sprint 20 and 5 more)") || strings.Contains(err.Error(), "sprint 21") {
+		t.Errorf("error = %v, want the first %d titles and a count of the rest", err, maxMilestoneSuggestions)
+	}
+}
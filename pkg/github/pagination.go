@@ -0,0 +1,168 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v69/github"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+)
+
+const (
+	// DefaultMaxItems is the default cap for automatic multi-page fetching
+	DefaultMaxItems = 100
+	// MaxItemsLimit is the upper bound for PaginationOptions.MaxItems
+	MaxItemsLimit = 1000
+	// MaxPerPage is the largest page size the GitHub API accepts
+	MaxPerPage = 100
+	// MaxScanPages caps the pages PaginateFiltered fetches while looking for matches
+	MaxScanPages = 10
+)
+
+// PaginationOptions controls how list operations fetch pages.
+// If Page is set, exactly that page is fetched. Otherwise pages are followed
+// via Response.NextPage until MaxItems items have been collected.
+type PaginationOptions struct {
+	Page     int
+	PerPage  int
+	MaxItems int
+}
+
+// ListResult holds the items returned by a list operation
+type ListResult[T any] struct {
+	Items []T
+	// Truncated is true if GitHub has more items than were returned
+	Truncated bool
+	// NextPage is the page to request next, 0 if there are no more pages
+	NextPage int
+	// Offset is the number of items of NextPage that were already returned,
+	// non-zero if MaxItems was reached partway through a page
+	Offset int
+	// PerPage is the page size NextPage refers to, 0 for GitHub's default
+	PerPage int
+	// MaxItems is the cap that was applied when fetching multiple pages, 0 in single-page mode
+	MaxItems int
+	// ScanLimited is true if PaginateFiltered stopped after MaxScanPages pages with fewer than MaxItems matches
+	ScanLimited bool
+}
+
+// Validate checks that the pagination options are within the limits of the GitHub API
+func (o PaginationOptions) Validate() error {
+	if o.Page < 0 {
+		return errors.NewValidationError("page must be greater than 0")
+	}
+	if o.PerPage < 0 || o.PerPage > MaxPerPage {
+		return errors.NewValidationError(fmt.Sprintf("per_page must be between 1 and %d", MaxPerPage))
+	}
+	if o.MaxItems < 0 || o.MaxItems > MaxItemsLimit {
+		return errors.NewValidationError(fmt.Sprintf("max_items must be between 1 and %d", MaxItemsLimit))
+	}
+	return nil
+}
+
+// Paginate calls fetch for consecutive pages and collects the items.
+// defaultPerPage is used when opts.PerPage is not set; 0 leaves the page size to GitHub. Errors returned by fetch are passed through unchanged.
+func Paginate[T any](ctx context.Context, opts PaginationOptions, defaultPerPage int, fetch func(listOpts github.ListOptions) ([]T, *github.Response, error)) (*ListResult[T], error) {
+	return PaginateFiltered(ctx, opts, defaultPerPage, nil, fetch)
+}
+
+// PaginateFiltered is Paginate for filters the GitHub API does not support: only items for which keep
+// returns true are collected, and MaxItems counts matches. Since a filter may drop most of a page,
+// full pages are fetched unless opts.PerPage is set, and at most MaxScanPages pages are scanned.
+// A nil keep collects every item, like Paginate.
+func PaginateFiltered[T any](ctx context.Context, opts PaginationOptions, defaultPerPage int, keep func(item T) bool, fetch func(listOpts github.ListOptions) ([]T, *github.Response, error)) (*ListResult[T], error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if keep != nil {
+		defaultPerPage = MaxPerPage
+	}
+	perPage := opts.PerPage
+	if perPage == 0 {
+		perPage = defaultPerPage
+	}
+
+	filter := func(items []T) []T {
+		if keep == nil {
+			return items
+		}
+		kept := make([]T, 0, len(items))
+		for _, item := range items {
+			if keep(item) {
+				kept = append(kept, item)
+			}
+		}
+		return kept
+	}
+
+	// Single page mode
+	if opts.Page > 0 {
+		items, resp, err := fetch(github.ListOptions{Page: opts.Page, PerPage: perPage})
+		if err != nil {
+			return nil, err
+		}
+		result := &ListResult[T]{Items: filter(items)}
+		if resp != nil && resp.NextPage != 0 {
+			result.Truncated = true
+			result.NextPage = resp.NextPage
+		}
+		return result, nil
+	}
+
+	maxItems := opts.MaxItems
+	if maxItems == 0 {
+		maxItems = DefaultMaxItems
+	}
+	if keep == nil && (perPage > maxItems || (perPage == 0 && maxItems < 30)) {
+		perPage = maxItems
+	}
+
+	result := &ListResult[T]{MaxItems: maxItems, PerPage: perPage}
+	listOpts := github.ListOptions{PerPage: perPage}
+	for scanned := 1; ; scanned++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		items, resp, err := fetch(listOpts)
+		if err != nil {
+			return nil, err
+		}
+		collected := len(result.Items)
+		result.Items = append(result.Items, filter(items)...)
+
+		nextPage := 0
+		if resp != nil {
+			nextPage = resp.NextPage
+		}
+
+		if len(result.Items) > maxItems {
+			// The rest of this page was dropped, so continue from this page rather than skipping it
+			result.Truncated = true
+			result.NextPage = max(listOpts.Page, 1)
+			result.Offset = maxItems - collected
+			result.Items = result.Items[:maxItems]
+			return result, nil
+		}
+		if len(result.Items) == maxItems {
+			if nextPage != 0 {
+				result.Truncated = true
+				result.NextPage = nextPage
+			}
+			return result, nil
+		}
+		if nextPage == 0 {
+			return result, nil
+		}
+		if keep != nil && scanned >= MaxScanPages {
+			result.Truncated = true
+			result.NextPage = nextPage
+			result.ScanLimited = true
+			return result, nil
+		}
+
+		listOpts.Page = nextPage
+	}
+}
@@ -0,0 +1,243 @@
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-github/v69/github"
+)
+
+// fakePages returns a fetch function serving items in pages of the requested size
+func fakePages(total int, requests *[]github.ListOptions) func(listOpts github.ListOptions) ([]int, *github.Response, error) {
+	return func(listOpts github.ListOptions) ([]int, *github.Response, error) {
+		*requests = append(*requests, listOpts)
+
+		page := listOpts.Page
+		if page == 0 {
+			page = 1
+		}
+		perPage := listOpts.PerPage
+		if perPage == 0 {
+			perPage = 30
+		}
+
+		start := (page - 1) * perPage
+		end := start + perPage
+		if end > total {
+			end = total
+		}
+
+		var items []int
+		for i := start; i < end; i++ {
+			items = append(items, i)
+		}
+
+		resp := &github.Response{}
+		if end < total {
+			resp.NextPage = page + 1
+		}
+		return items, resp, nil
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	testCases := []struct {
+		name           string
+		total          int
+		opts           PaginationOptions
+		defaultPerPage int
+		wantItems      int
+		wantTruncated  bool
+		wantNextPage   int
+		wantOffset     int
+		wantRequests   int
+	}{
+		{
+			name:           "SinglePageFitsDefault",
+			total:          10,
+			defaultPerPage: 30,
+			wantItems:      10,
+			wantRequests:   1,
+		},
+		{
+			name:           "FollowsNextPage",
+			total:          70,
+			defaultPerPage: 30,
+			wantItems:      70,
+			wantRequests:   3,
+		},
+		{
+			name:           "CapsAtDefaultMaxItems",
+			total:          250,
+			defaultPerPage: 30,
+			wantItems:      DefaultMaxItems,
+			wantTruncated:  true,
+			wantNextPage:   4,
+			wantOffset:     10,
+			wantRequests:   4,
+		},
+		{
+			name:           "CapsAtMaxItems",
+			total:          50,
+			opts:           PaginationOptions{MaxItems: 5},
+			defaultPerPage: 30,
+			wantItems:      5,
+			wantTruncated:  true,
+			wantNextPage:   2,
+			wantRequests:   1,
+		},
+		{
+			name:           "ExplicitPage",
+			total:          50,
+			opts:           PaginationOptions{Page: 2, PerPage: 20},
+			defaultPerPage: 30,
+			wantItems:      20,
+			wantTruncated:  true,
+			wantNextPage:   3,
+			wantRequests:   1,
+		},
+		{
+			name:           "ExplicitLastPage",
+			total:          50,
+			opts:           PaginationOptions{Page: 3, PerPage: 20},
+			defaultPerPage: 30,
+			wantItems:      10,
+			wantRequests:   1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests []github.ListOptions
+			result, err := Paginate(context.Background(), tc.opts, tc.defaultPerPage, fakePages(tc.total, &requests))
+			if err != nil {
+				t.Fatalf("Paginate() error = %v", err)
+			}
+			if len(result.Items) != tc.wantItems {
+				t.Errorf("len(Items) = %d, want %d", len(result.Items), tc.wantItems)
+			}
+			if result.Truncated != tc.wantTruncated {
+				t.Errorf("Truncated = %t, want %t", result.Truncated, tc.wantTruncated)
+			}
+			if result.NextPage != tc.wantNextPage {
+				t.Errorf("NextPage = %d, want %d", result.NextPage, tc.wantNextPage)
+			}
+			if result.Offset != tc.wantOffset {
+				t.Errorf("Offset = %d, want %d", result.Offset, tc.wantOffset)
+			}
+			if len(requests) != tc.wantRequests {
+				t.Errorf("requests = %d, want %d", len(requests), tc.wantRequests)
+			}
+			if len(requests) > 0 && tc.opts.Page == 0 && requests[0].Page != 0 {
+				t.Errorf("first request page = %d, want 0 so the URL matches a plain list request", requests[0].Page)
+			}
+		})
+	}
+}
+
+func TestPaginateValidation(t *testing.T) {
+	invalid := []PaginationOptions{
+		{Page: -1},
+		{PerPage: 101},
+		{MaxItems: MaxItemsLimit + 1},
+	}
+	for _, opts := range invalid {
+		var requests []github.ListOptions
+		if _, err := Paginate(context.Background(), opts, 30, fakePages(10, &requests)); err == nil {
+			t.Errorf("Paginate(%+v) expected an error", opts)
+		}
+		if len(requests) != 0 {
+			t.Errorf("Paginate(%+v) made %d requests, want 0", opts, len(requests))
+		}
+	}
+}
+
+func TestPaginateFiltered(t *testing.T) {
+	multipleOf := func(n int) func(item int) bool {
+		return func(item int) bool { return item%n == 0 }
+	}
+
+	testCases := []struct {
+		name            string
+		total           int
+		opts            PaginationOptions
+		keep            func(item int) bool
+		wantItems       int
+		wantTruncated   bool
+		wantScanLimited bool
+		wantNextPage    int
+		wantOffset      int
+		wantRequests    int
+	}{
+		{
+			name:          "SinglePageFiltersFullPage",
+			total:         250,
+			opts:          PaginationOptions{Page: 1},
+			keep:          multipleOf(10),
+			wantItems:     10,
+			wantTruncated: true,
+			wantRequests:  1,
+		},
+		{
+			name:         "MaxItemsCountsMatches",
+			total:        150,
+			opts:         PaginationOptions{MaxItems: 15},
+			keep:         multipleOf(10),
+			wantItems:    15,
+			wantRequests: 2,
+		},
+		{
+			name:          "MaxItemsCountsMatchesTruncated",
+			total:         250,
+			opts:          PaginationOptions{MaxItems: 12},
+			keep:          multipleOf(10),
+			wantItems:     12,
+			wantTruncated: true,
+			wantNextPage:  2,
+			wantOffset:    2,
+			wantRequests:  2,
+		},
+		{
+			name:            "StopsAtScanLimit",
+			total:           5000,
+			opts:            PaginationOptions{MaxItems: 100},
+			keep:            multipleOf(1000),
+			wantItems:       1,
+			wantTruncated:   true,
+			wantScanLimited: true,
+			wantRequests:    MaxScanPages,
+		},
+		{
+			name:         "NilKeepCollectsEverything",
+			total:        70,
+			opts:         PaginationOptions{MaxItems: 100},
+			wantItems:    70,
+			wantRequests: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests []github.ListOptions
+			result, err := PaginateFiltered(context.Background(), tc.opts, 30, tc.keep, fakePages(tc.total, &requests))
+			if err != nil {
+				t.Fatalf("PaginateFiltered() error = %v", err)
+			}
+			if len(result.Items) != tc.wantItems {
+				t.Errorf("len(Items) = %d, want %d", len(result.Items), tc.wantItems)
+			}
+			if result.Truncated != tc.wantTruncated {
+				t.Errorf("Truncated = %t, want %t", result.Truncated, tc.wantTruncated)
+			}
+			if tc.wantNextPage != 0 && (result.NextPage != tc.wantNextPage || result.Offset != tc.wantOffset) {
+				t.Errorf("NextPage, Offset = %d, %d, want %d, %d", result.NextPage, result.Offset, tc.wantNextPage, tc.wantOffset)
+			}
+			if result.ScanLimited != tc.wantScanLimited {
+				t.Errorf("ScanLimited = %t, want %t", result.ScanLimited, tc.wantScanLimited)
+			}
+			if len(requests) != tc.wantRequests {
+				t.Errorf("requests = %d, want %d", len(requests), tc.wantRequests)
+			}
+		})
+	}
+}
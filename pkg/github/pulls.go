@@ -3,7 +3,11 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/go-github/v69/github"
 	"github.com/sirupsen/logrus"
@@ -15,13 +19,20 @@ import (
 type PullRequestOperations struct {
 	client *Client
 	logger *logrus.Logger
+
+	// mergeablePollInterval and mergeablePollAttempts control how long MergePullRequest and
+	// GetPullRequestStatus wait for GitHub to compute the mergeable state
+	mergeablePollInterval time.Duration
+	mergeablePollAttempts int
 }
 
 // NewPullRequestOperations creates a new PullRequestOperations
 func NewPullRequestOperations(client *Client, logger *logrus.Logger) *PullRequestOperations {
 	return &PullRequestOperations{
-		client: client,
-		logger: logger,
+		client:                client,
+		logger:                logger,
+		mergeablePollInterval: 2 * time.Second,
+		mergeablePollAttempts: 10,
 	}
 }
 
@@ -61,6 +72,104 @@ func (p *PullRequestOperations) CreatePullRequest(ctx context.Context, owner, re
 	return pr, nil
 }
 
+// PullRequestFilter holds the filters for listing pull requests.
+// State, Base, Head, Sort and Direction are passed to the GitHub API; Draft, Author and Labels
+// are not supported by the pulls endpoint and are applied to each fetched page.
+type PullRequestFilter struct {
+	State     string
+	Base      string
+	Head      string
+	Sort      string
+	Direction string
+	Draft     *bool
+	Author    string
+	Labels    []string
+}
+
+// ListPullRequests lists pull requests in a repository
+func (p *PullRequestOperations) ListPullRequests(ctx context.Context, owner, repo string, filter PullRequestFilter, pagination PaginationOptions) (*ListResult[*github.PullRequest], error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	switch filter.State {
+	case "", "open", "closed", "all":
+	default:
+		return nil, errors.NewValidationError("state must be one of: open, closed, all")
+	}
+	switch filter.Sort {
+	case "", "created", "updated", "popularity", "long-running":
+	default:
+		return nil, errors.NewValidationError("sort must be one of: created, updated, popularity, long-running")
+	}
+	switch filter.Direction {
+	case "", "asc", "desc":
+	default:
+		return nil, errors.NewValidationError("direction must be either asc or desc")
+	}
+
+	// The API expects head in the form "user:ref-name"
+	head := filter.Head
+	if head != "" && !strings.Contains(head, ":") {
+		head = owner + ":" + head
+	}
+
+	// Set up options
+	opts := &github.PullRequestListOptions{
+		State:     filter.State,
+		Head:      head,
+		Base:      filter.Base,
+		Sort:      filter.Sort,
+		Direction: filter.Direction,
+	}
+
+	// Draft, author and labels are not supported by the pulls endpoint
+	var keep func(pr *github.PullRequest) bool
+	if filter.Draft != nil || filter.Author != "" || len(filter.Labels) > 0 {
+		keep = filter.matches
+	}
+
+	// List pull requests
+	result, err := PaginateFiltered(ctx, pagination, 30, keep, func(listOpts github.ListOptions) ([]*github.PullRequest, *github.Response, error) {
+		opts.ListOptions = listOpts
+		return p.client.GetClient().PullRequests.List(ctx, owner, repo, opts)
+	})
+	if err != nil {
+		return nil, p.client.HandleError(err)
+	}
+
+	return result, nil
+}
+
+// matches reports whether a pull request satisfies the client-side filters
+func (f PullRequestFilter) matches(pr *github.PullRequest) bool {
+	if f.Draft != nil && pr.GetDraft() != *f.Draft {
+		return false
+	}
+	if f.Author != "" && !strings.EqualFold(pr.GetUser().GetLogin(), f.Author) {
+		return false
+	}
+
+	// All labels must be present
+	for _, want := range f.Labels {
+		found := false
+		for _, label := range pr.Labels {
+			if strings.EqualFold(label.GetName(), want) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
 // GetPullRequest gets a pull request
 func (p *PullRequestOperations) GetPullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequest, error) {
 	// Validate parameters
@@ -83,6 +192,223 @@ func (p *PullRequestOperations) GetPullRequest(ctx context.Context, owner, repo
 	return pr, nil
 }
 
+// PullRequestUpdate holds the changes to apply to a pull request. Nil fields are left unchanged.
+type PullRequestUpdate struct {
+	Title *string
+	Body  *string
+	Base  *string
+	State *string
+	Draft *bool
+	// ExpectedUpdatedAt rejects the update if the pull request was modified after this time
+	ExpectedUpdatedAt *time.Time
+}
+
+// UpdatePullRequest updates a pull request.
+// Title, body, base and state are changed via the REST API; draft conversion is only available via GraphQL
+// and is applied first.
+func (p *PullRequestOperations) UpdatePullRequest(ctx context.Context, owner, repo string, number int, update PullRequestUpdate) (*github.PullRequest, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+	if update.Title != nil && *update.Title == "" {
+		return nil, errors.NewValidationError("title cannot be empty")
+	}
+	if update.Base != nil && *update.Base == "" {
+		return nil, errors.NewValidationError("base cannot be empty")
+	}
+	if update.State != nil && *update.State != "open" && *update.State != "closed" {
+		return nil, errors.NewValidationError("state must be either open or closed")
+	}
+	restChanges := update.Title != nil || update.Body != nil || update.Base != nil || update.State != nil
+	if !restChanges && update.Draft == nil {
+		return nil, errors.NewValidationError("at least one of title, body, base, state or draft must be set")
+	}
+
+	// Get the current pull request for the concurrency check and the draft state
+	pr, _, err := p.client.GetClient().PullRequests.Get(ctx, owner, repo, number)
+	if err != nil {
+		return nil, p.client.HandleError(err)
+	}
+
+	// GitHub has no conditional updates for pull requests, so this check narrows but does not close the race window
+	if update.ExpectedUpdatedAt != nil && !pr.GetUpdatedAt().Time.Equal(*update.ExpectedUpdatedAt) {
+		return nil, errors.NewConflictError(fmt.Sprintf("pull request #%d was updated at %s, after the expected %s; re-read it and retry",
+			number, pr.GetUpdatedAt().Format(time.RFC3339), update.ExpectedUpdatedAt.Format(time.RFC3339)))
+	}
+
+	// Toggle the draft state first: it is the GraphQL half of the update and the one more likely to fail,
+	// so a failure leaves the pull request unchanged
+	toggled := update.Draft != nil && *update.Draft != pr.GetDraft()
+	if toggled {
+		mutation := `mutation($id: ID!) {
+  markPullRequestReadyForReview(input: {pullRequestId: $id}) { pullRequest { isDraft } }
+}`
+		if *update.Draft {
+			mutation = `mutation($id: ID!) {
+  convertPullRequestToDraft(input: {pullRequestId: $id}) { pullRequest { isDraft } }
+}`
+		}
+		if err := p.client.GraphQL().Mutate(ctx, mutation, map[string]interface{}{"id": pr.GetNodeID()}, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if restChanges {
+		edit := &github.PullRequest{
+			Title: update.Title,
+			Body:  update.Body,
+			State: update.State,
+		}
+		if update.Base != nil {
+			edit.Base = &github.PullRequestBranch{Ref: update.Base}
+		}
+
+		pr, _, err = p.client.GetClient().PullRequests.Edit(ctx, owner, repo, number, edit)
+		if err != nil {
+			err = p.client.HandleError(err)
+			if ghErr, ok := errors.AsGitHubError(err); ok && toggled {
+				ghErr.Message = fmt.Sprintf("pull request #%d was %s, but the other changes failed: %s", number, draftChange(*update.Draft), ghErr.Message)
+			}
+			return nil, err
+		}
+		return pr, nil
+	}
+
+	if toggled {
+		// Re-read the pull request to return its final state
+		pr, _, err = p.client.GetClient().PullRequests.Get(ctx, owner, repo, number)
+		if err != nil {
+			return nil, p.client.HandleError(err)
+		}
+	}
+
+	return pr, nil
+}
+
+// draftChange describes a draft toggle
+func draftChange(draft bool) string {
+	if draft {
+		return "converted to a draft"
+	}
+	return "marked ready for review"
+}
+
+// MergePullRequestOptions holds the options for merging a pull request
+type MergePullRequestOptions struct {
+	// MergeMethod is one of merge, squash or rebase
+	MergeMethod   string
+	CommitTitle   string
+	CommitMessage string
+	// ExpectedHeadSHA rejects the merge if the head of the pull request moved
+	ExpectedHeadSHA string
+}
+
+// MergePullRequest merges a pull request after waiting for GitHub to compute its mergeable state
+func (p *PullRequestOperations) MergePullRequest(ctx context.Context, owner, repo string, number int, opts MergePullRequestOptions) (*github.PullRequestMergeResult, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+	switch opts.MergeMethod {
+	case "", "merge", "squash", "rebase":
+	default:
+		return nil, errors.NewValidationError("merge_method must be one of: merge, squash, rebase")
+	}
+	if opts.MergeMethod == "rebase" && (opts.CommitTitle != "" || opts.CommitMessage != "") {
+		return nil, errors.NewValidationError("commit_title and commit_message cannot be used with the rebase merge method")
+	}
+
+	pr, err := p.waitForMergeable(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the state before attempting the merge for precise errors
+	if pr.GetMerged() {
+		return nil, errors.NewConflictError(fmt.Sprintf("pull request #%d is already merged", number))
+	}
+	if pr.GetState() != "open" {
+		return nil, errors.NewValidationError(fmt.Sprintf("pull request #%d is %s", number, pr.GetState()))
+	}
+	if opts.ExpectedHeadSHA != "" && pr.GetHead().GetSHA() != opts.ExpectedHeadSHA {
+		return nil, errors.NewConflictError(fmt.Sprintf("head of pull request #%d is %s, not the expected %s; review the new commits and retry",
+			number, pr.GetHead().GetSHA(), opts.ExpectedHeadSHA))
+	}
+	if pr.Mergeable != nil && !pr.GetMergeable() && pr.GetMergeableState() == "dirty" {
+		return nil, errors.NewConflictError(fmt.Sprintf("pull request #%d has merge conflicts with %s", number, pr.GetBase().GetRef()))
+	}
+	if pr.GetMergeableState() == "draft" || pr.GetDraft() {
+		return nil, errors.NewPreconditionError(fmt.Sprintf("pull request #%d is a draft; mark it as ready for review first", number))
+	}
+
+	// Merge the pull request; the SHA makes GitHub reject the merge if the head moves in the meantime
+	result, _, err := p.client.GetClient().PullRequests.Merge(ctx, owner, repo, number, opts.CommitMessage, &github.PullRequestOptions{
+		CommitTitle: opts.CommitTitle,
+		SHA:         opts.ExpectedHeadSHA,
+		MergeMethod: opts.MergeMethod,
+	})
+	if err != nil {
+		return nil, p.handleMergeError(err, pr)
+	}
+
+	return result, nil
+}
+
+// waitForMergeable polls a pull request until GitHub has computed its mergeable state.
+// If it is still unknown after all attempts, the last pull request is returned and the merge API decides.
+func (p *PullRequestOperations) waitForMergeable(ctx context.Context, owner, repo string, number int) (*github.PullRequest, error) {
+	for attempt := 1; ; attempt++ {
+		pr, _, err := p.client.GetClient().PullRequests.Get(ctx, owner, repo, number)
+		if err != nil {
+			return nil, p.client.HandleError(err)
+		}
+		if pr.Mergeable != nil || pr.GetState() != "open" || attempt >= p.mergeablePollAttempts {
+			return pr, nil
+		}
+
+		p.logger.Debugf("Mergeable state of %s/%s#%d not computed yet, retrying (%d/%d)", owner, repo, number, attempt, p.mergeablePollAttempts)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(p.mergeablePollInterval):
+		}
+	}
+}
+
+// handleMergeError maps errors of the merge API, which uses 405 for unmet requirements
+func (p *PullRequestOperations) handleMergeError(err error, pr *github.PullRequest) error {
+	ghErr, ok := p.client.HandleError(err).(*errors.GitHubError)
+	if !ok {
+		return err
+	}
+
+	switch ghErr.StatusCode {
+	case 405:
+		// Required checks, reviews or other protection rules are not satisfied
+		ghErr.Type = errors.ErrorTypePrecondition
+		if state := pr.GetMergeableState(); state != "" && state != "clean" && state != "unknown" {
+			ghErr.Message = fmt.Sprintf("%s (mergeable state: %s)", ghErr.Message, state)
+		}
+	case 409:
+		// The head branch was modified after the SHA was checked
+		ghErr.Type = errors.ErrorTypeConflict
+	}
+	return ghErr
+}
+
 // GetPullRequestDiff gets the diff of a pull request
 func (p *PullRequestOperations) GetPullRequestDiff(ctx context.Context, owner, repo string, number int) (string, error) {
 	// Validate parameters
@@ -113,3 +439,407 @@ func (p *PullRequestOperations) GetPullRequestDiff(ctx context.Context, owner, r
 
 	return buf.String(), nil
 }
+
+// ListPullRequestFiles lists the files changed by a pull request, optionally filtered by path globs.
+// GitHub returns at most 3000 files per pull request.
+func (p *PullRequestOperations) ListPullRequestFiles(ctx context.Context, owner, repo string, number int, paths PathFilter, pagination PaginationOptions) (*ListResult[*github.CommitFile], error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+	if err := paths.Validate(); err != nil {
+		return nil, err
+	}
+
+	// A renamed file matches if either its old or new path does
+	var keep func(file *github.CommitFile) bool
+	if !paths.IsEmpty() {
+		keep = func(file *github.CommitFile) bool {
+			return paths.Matches(file.GetFilename()) || (file.GetPreviousFilename() != "" && paths.Matches(file.GetPreviousFilename()))
+		}
+	}
+
+	// List files
+	result, err := PaginateFiltered(ctx, pagination, 30, keep, func(listOpts github.ListOptions) ([]*github.CommitFile, *github.Response, error) {
+		return p.client.GetClient().PullRequests.ListFiles(ctx, owner, repo, number, &listOpts)
+	})
+	if err != nil {
+		return nil, p.client.HandleError(err)
+	}
+
+	return result, nil
+}
+
+// PullRequestStatus aggregates everything that decides whether a pull request can be merged
+type PullRequestStatus struct {
+	PullRequest    *github.PullRequest
+	CombinedStatus *github.CombinedStatus
+	CheckRuns      []*github.CheckRun
+	// LatestReviews holds the latest approving or change-requesting review of each reviewer
+	LatestReviews []*github.PullRequestReview
+	// ReviewDecision is GitHub's overall verdict (APPROVED, CHANGES_REQUESTED, REVIEW_REQUIRED),
+	// empty if the base branch requires no reviews
+	ReviewDecision string
+	// BehindBy is the number of base branch commits missing from the head branch, -1 if unknown
+	BehindBy int
+	// Blockers lists why the pull request cannot be merged, empty if it can
+	Blockers []string
+	// Warnings lists parts of the status that could not be fetched
+	Warnings []string
+}
+
+// GetPullRequestStatus combines mergeability, commit statuses, check runs, reviews and the
+// behind-by count of a pull request into a single report.
+// Only failing to get the pull request itself is an error; other failures are reported as warnings.
+func (p *PullRequestOperations) GetPullRequestStatus(ctx context.Context, owner, repo string, number int) (*PullRequestStatus, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+
+	pr, err := p.waitForMergeable(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &PullRequestStatus{PullRequest: pr, BehindBy: -1}
+	headSHA := pr.GetHead().GetSHA()
+
+	// Commit statuses
+	combined, err := NewCommitOperations(p.client, p.logger).GetCommitStatus(ctx, owner, repo, headSHA)
+	if err != nil {
+		status.Warnings = append(status.Warnings, fmt.Sprintf("commit statuses unavailable: %v", err))
+	}
+	status.CombinedStatus = combined
+
+	// Check runs
+	checkRuns, err := Paginate(ctx, PaginationOptions{MaxItems: MaxItemsLimit}, MaxPerPage, func(listOpts github.ListOptions) ([]*github.CheckRun, *github.Response, error) {
+		result, resp, err := p.client.GetClient().Checks.ListCheckRunsForRef(ctx, owner, repo, headSHA, &github.ListCheckRunsOptions{
+			Filter:      github.String("latest"),
+			ListOptions: listOpts,
+		})
+		if err != nil {
+			return nil, resp, err
+		}
+		return result.CheckRuns, resp, nil
+	})
+	if err != nil {
+		status.Warnings = append(status.Warnings, fmt.Sprintf("check runs unavailable: %v", p.client.HandleError(err)))
+	} else {
+		status.CheckRuns = checkRuns.Items
+	}
+
+	// Reviews
+	reviews, err := Paginate(ctx, PaginationOptions{MaxItems: MaxItemsLimit}, MaxPerPage, func(listOpts github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
+		return p.client.GetClient().PullRequests.ListReviews(ctx, owner, repo, number, &listOpts)
+	})
+	if err != nil {
+		status.Warnings = append(status.Warnings, fmt.Sprintf("reviews unavailable: %v", p.client.HandleError(err)))
+	} else {
+		status.LatestReviews = latestReviews(reviews.Items)
+	}
+
+	// The review decision takes branch protection into account, which the REST API only exposes to admins
+	decision, err := GraphQLQuery[struct {
+		Repository struct {
+			PullRequest struct {
+				ReviewDecision string `json:"reviewDecision"`
+			} `json:"pullRequest"`
+		} `json:"repository"`
+	}](ctx, p.client.GraphQL(), `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) { reviewDecision }
+  }
+}`, map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+	})
+	if err != nil {
+		status.Warnings = append(status.Warnings, fmt.Sprintf("review decision unavailable: %v", err))
+	} else {
+		status.ReviewDecision = decision.Repository.PullRequest.ReviewDecision
+	}
+
+	// Behind-by count against the base branch
+	comparison, _, err := p.client.GetClient().Repositories.CompareCommits(ctx, owner, repo, pr.GetBase().GetRef(), headSHA, nil)
+	if err != nil {
+		status.Warnings = append(status.Warnings, fmt.Sprintf("comparison with base unavailable: %v", p.client.HandleError(err)))
+	} else {
+		status.BehindBy = comparison.GetBehindBy()
+	}
+
+	status.Blockers = status.blockers()
+	return status, nil
+}
+
+// latestReviews returns the latest approving or change-requesting review of each reviewer.
+// Comments do not change a reviewer's verdict, but a dismissal clears it.
+func latestReviews(reviews []*github.PullRequestReview) []*github.PullRequestReview {
+	latest := make(map[string]*github.PullRequestReview)
+	var order []string
+	for _, review := range reviews {
+		login := review.GetUser().GetLogin()
+		switch review.GetState() {
+		case "APPROVED", "CHANGES_REQUESTED", "DISMISSED":
+		default:
+			continue
+		}
+		if _, ok := latest[login]; !ok {
+			order = append(order, login)
+		}
+		latest[login] = review
+	}
+
+	result := make([]*github.PullRequestReview, 0, len(order))
+	for _, login := range order {
+		if review := latest[login]; review.GetState() != "DISMISSED" {
+			result = append(result, review)
+		}
+	}
+	return result
+}
+
+// CheckRunCounts returns the number of passed, failed and pending check runs
+func (s *PullRequestStatus) CheckRunCounts() (passed, failed, pending int) {
+	for _, run := range s.CheckRuns {
+		if run.GetStatus() != "completed" {
+			pending++
+			continue
+		}
+		switch run.GetConclusion() {
+		case "success", "neutral", "skipped":
+			passed++
+		default:
+			failed++
+		}
+	}
+	return passed, failed, pending
+}
+
+// blockers explains why the pull request cannot be merged
+func (s *PullRequestStatus) blockers() []string {
+	pr := s.PullRequest
+	if pr.GetMerged() {
+		return []string{"the pull request is already merged"}
+	}
+	if pr.GetState() != "open" {
+		return []string{"the pull request is closed"}
+	}
+
+	var blockers []string
+	if pr.GetDraft() {
+		blockers = append(blockers, "the pull request is a draft")
+	}
+
+	switch pr.GetMergeableState() {
+	case "dirty":
+		blockers = append(blockers, "the head branch has merge conflicts with the base branch")
+	case "behind":
+		if s.BehindBy < 0 {
+			blockers = append(blockers, "the head branch is behind the base branch, which must be up to date")
+		} else {
+			blockers = append(blockers, fmt.Sprintf("the head branch is %d commits behind the base branch, which must be up to date", s.BehindBy))
+		}
+	case "unknown", "":
+		if pr.Mergeable == nil {
+			blockers = append(blockers, "GitHub has not computed the mergeable state yet, retry shortly")
+		}
+	case "blocked":
+		// Explain the protection rules that are not satisfied
+		reasons := len(blockers)
+		switch s.ReviewDecision {
+		case "CHANGES_REQUESTED":
+			blockers = append(blockers, "changes were requested by a reviewer")
+		case "REVIEW_REQUIRED":
+			blockers = append(blockers, "an approving review is required")
+		}
+		if _, failed, pending := s.CheckRunCounts(); failed > 0 || pending > 0 {
+			blockers = append(blockers, fmt.Sprintf("check runs are not passing (%d failed, %d pending)", failed, pending))
+		}
+		if s.CombinedStatus.GetTotalCount() > 0 && s.CombinedStatus.GetState() != "success" {
+			blockers = append(blockers, fmt.Sprintf("commit statuses are %s", s.CombinedStatus.GetState()))
+		}
+		if len(blockers) == reasons {
+			blockers = append(blockers, "blocked by branch protection rules (e.g. code owner review, signed commits or resolved conversations)")
+		}
+	}
+
+	return blockers
+}
+
+// UpdatePullRequestBranch merges the latest changes of the base branch into the head branch.
+// GitHub updates the branch asynchronously; the returned message says that the update was scheduled.
+func (p *PullRequestOperations) UpdatePullRequestBranch(ctx context.Context, owner, repo string, number int, expectedHeadSHA string) (string, error) {
+	// Validate parameters
+	if owner == "" {
+		return "", errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return "", errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return "", errors.NewValidationError("number must be greater than 0")
+	}
+
+	opts := &github.PullRequestBranchUpdateOptions{}
+	if expectedHeadSHA != "" {
+		opts.ExpectedHeadSHA = github.String(expectedHeadSHA)
+	}
+
+	// Update the branch; GitHub answers 202 Accepted on success
+	_, _, err := p.client.GetClient().PullRequests.UpdateBranch(ctx, owner, repo, number, opts)
+	if err != nil {
+		var acceptedErr *github.AcceptedError
+		if !stderrors.As(err, &acceptedErr) {
+			ghErr, ok := p.client.HandleError(err).(*errors.GitHubError)
+			if ok && ghErr.StatusCode == 422 && strings.Contains(strings.ToLower(ghErr.Message), "expected head sha") {
+				// The head branch moved since the caller looked at it
+				ghErr.Type = errors.ErrorTypeConflict
+			}
+			if ok {
+				return "", ghErr
+			}
+			return "", err
+		}
+
+		var result github.PullRequestBranchUpdateResponse
+		if jsonErr := json.Unmarshal(acceptedErr.Raw, &result); jsonErr == nil && result.GetMessage() != "" {
+			return result.GetMessage(), nil
+		}
+	}
+
+	return "Updating pull request branch.", nil
+}
+
+// AutoMergeOptions holds the options for enabling auto-merge
+type AutoMergeOptions struct {
+	// MergeMethod is one of merge, squash or rebase
+	MergeMethod   string
+	CommitTitle   string
+	CommitMessage string
+	// ExpectedHeadSHA rejects enabling auto-merge if the head of the pull request moved
+	ExpectedHeadSHA string
+}
+
+// AutoMergeRequest describes the auto-merge settings of a pull request
+type AutoMergeRequest struct {
+	EnabledAt   time.Time `json:"enabledAt"`
+	MergeMethod string    `json:"mergeMethod"`
+	EnabledBy   struct {
+		Login string `json:"login"`
+	} `json:"enabledBy"`
+}
+
+// EnableAutoMerge makes GitHub merge the pull request as soon as all requirements are met.
+// Auto-merge is only available via GraphQL and must be allowed in the repository settings.
+// It returns a nil AutoMergeRequest if GitHub merged the pull request right away.
+func (p *PullRequestOperations) EnableAutoMerge(ctx context.Context, owner, repo string, number int, opts AutoMergeOptions) (*AutoMergeRequest, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+	switch opts.MergeMethod {
+	case "merge", "squash", "rebase":
+	default:
+		return nil, errors.NewValidationError("merge_method must be one of: merge, squash, rebase")
+	}
+	if opts.MergeMethod == "rebase" && (opts.CommitTitle != "" || opts.CommitMessage != "") {
+		return nil, errors.NewValidationError("commit_title and commit_message cannot be used with the rebase merge method")
+	}
+
+	nodeID, err := p.client.GraphQL().GetPullRequestNodeID(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	input := map[string]interface{}{
+		"pullRequestId": nodeID,
+		"mergeMethod":   strings.ToUpper(opts.MergeMethod),
+	}
+	if opts.CommitTitle != "" {
+		input["commitHeadline"] = opts.CommitTitle
+	}
+	if opts.CommitMessage != "" {
+		input["commitBody"] = opts.CommitMessage
+	}
+	if opts.ExpectedHeadSHA != "" {
+		input["expectedHeadOid"] = opts.ExpectedHeadSHA
+	}
+
+	result, err := GraphQLMutate[struct {
+		EnablePullRequestAutoMerge struct {
+			PullRequest struct {
+				AutoMergeRequest *AutoMergeRequest `json:"autoMergeRequest"`
+			} `json:"pullRequest"`
+		} `json:"enablePullRequestAutoMerge"`
+	}](ctx, p.client.GraphQL(), `mutation($input: EnablePullRequestAutoMergeInput!) {
+  enablePullRequestAutoMerge(input: $input) {
+    pullRequest { autoMergeRequest { enabledAt mergeMethod enabledBy { login } } }
+  }
+}`, map[string]interface{}{"input": input})
+	if err != nil {
+		return nil, err
+	}
+
+	autoMerge := result.EnablePullRequestAutoMerge.PullRequest.AutoMergeRequest
+	if autoMerge != nil {
+		return autoMerge, nil
+	}
+
+	// No auto-merge request usually means GitHub merged the pull request right away,
+	// but the mutation does not say so; read the pull request to find out
+	pr, _, err := p.client.GetClient().PullRequests.Get(ctx, owner, repo, number)
+	if err != nil {
+		err = p.client.HandleError(err)
+		if ghErr, ok := errors.AsGitHubError(err); ok {
+			ghErr.Message = fmt.Sprintf("auto-merge was not enabled for pull request #%d and its state could not be read: %s", number, ghErr.Message)
+		}
+		return nil, err
+	}
+	if pr.GetMerged() {
+		return nil, nil
+	}
+	return nil, errors.NewConflictError(fmt.Sprintf("auto-merge was not enabled for pull request #%d and it was not merged (state: %s); check get_pull_request_status", number, pr.GetState()))
+}
+
+// DisableAutoMerge cancels auto-merge of a pull request
+func (p *PullRequestOperations) DisableAutoMerge(ctx context.Context, owner, repo string, number int) error {
+	// Validate parameters
+	if owner == "" {
+		return errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return errors.NewValidationError("number must be greater than 0")
+	}
+
+	nodeID, err := p.client.GraphQL().GetPullRequestNodeID(ctx, owner, repo, number)
+	if err != nil {
+		return err
+	}
+
+	return p.client.GraphQL().Mutate(ctx, `mutation($id: ID!) {
+  disablePullRequestAutoMerge(input: {pullRequestId: $id}) { pullRequest { id } }
+}`, map[string]interface{}{"id": nodeID}, nil)
+}
@@ -0,0 +1,550 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/sirupsen/logrus"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+)
+
+func TestListPullRequests(t *testing.T) {
+	var query map[string][]string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/octo/repo/pulls" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{"number": 1, "draft": false, "user": {"login": "alice"}, "labels": [{"name": "bug"}]},
+			{"number": 2, "draft": true, "user": {"login": "alice"}, "labels": [{"name": "bug"}]},
+			{"number": 3, "draft": false, "user": {"login": "bob"}, "labels": [{"name": "Bug"}, {"name": "ui"}]},
+			{"number": 4, "draft": false, "user": {"login": "alice"}, "labels": []}
+		]`))
+	})
+	prOps := NewPullRequestOperations(client, logrus.New())
+
+	draft := false
+	result, err := prOps.ListPullRequests(context.Background(), "octo", "repo", PullRequestFilter{
+		State:  "open",
+		Head:   "feature",
+		Draft:  &draft,
+		Labels: []string{"bug"},
+	}, PaginationOptions{})
+	if err != nil {
+		t.Fatalf("ListPullRequests() error = %v", err)
+	}
+
+	if got := query["head"]; len(got) != 1 || got[0] != "octo:feature" {
+		t.Errorf("head = %v, want octo:feature", got)
+	}
+	if got := query["per_page"]; len(got) != 1 || got[0] != "100" {
+		t.Errorf("per_page = %v, want 100 when client-side filters are used", got)
+	}
+
+	var numbers []int
+	for _, pr := range result.Items {
+		numbers = append(numbers, pr.GetNumber())
+	}
+	if len(numbers) != 2 || numbers[0] != 1 || numbers[1] != 3 {
+		t.Errorf("numbers = %v, want [1 3]", numbers)
+	}
+
+	result, err = prOps.ListPullRequests(context.Background(), "octo", "repo", PullRequestFilter{Author: "ALICE"}, PaginationOptions{})
+	if err != nil {
+		t.Fatalf("ListPullRequests() error = %v", err)
+	}
+	if len(result.Items) != 3 {
+		t.Errorf("got %d pull requests by alice, want 3", len(result.Items))
+	}
+}
+
+func TestListPullRequestsValidation(t *testing.T) {
+	prOps := NewPullRequestOperations(NewClient("", logrus.New()), logrus.New())
+
+	testCases := []struct {
+		name   string
+		filter PullRequestFilter
+	}{
+		{name: "InvalidState", filter: PullRequestFilter{State: "merged"}},
+		{name: "InvalidSort", filter: PullRequestFilter{Sort: "comments"}},
+		{name: "InvalidDirection", filter: PullRequestFilter{Direction: "up"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := prOps.ListPullRequests(context.Background(), "octo", "repo", tc.filter, PaginationOptions{}); err == nil {
+				t.Error("expected a validation error")
+			}
+		})
+	}
+}
+
+func TestUpdatePullRequest(t *testing.T) {
+	const prJSON = `{"number": 5, "node_id": "PR_node5", "state": "open", "draft": %t, "updated_at": "2025-03-07T09:00:00Z"}`
+
+	t.Run("EditsAndConvertsToDraft", func(t *testing.T) {
+		var edit map[string]interface{}
+		var graphQL graphQLRequest
+		isDraft := false
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/repos/octo/repo/pulls/5":
+				fmt.Fprintf(w, prJSON, isDraft)
+			case r.Method == http.MethodPatch && r.URL.Path == "/repos/octo/repo/pulls/5":
+				json.NewDecoder(r.Body).Decode(&edit)
+				fmt.Fprintf(w, prJSON, isDraft)
+			case r.Method == http.MethodPost && r.URL.Path == "/graphql":
+				json.NewDecoder(r.Body).Decode(&graphQL)
+				isDraft = true
+				w.Write([]byte(`{"data":{"convertPullRequestToDraft":{"pullRequest":{"isDraft":true}}}}`))
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+		prOps := NewPullRequestOperations(client, logrus.New())
+
+		title, base := "New title", "develop"
+		draft := true
+		expected := time.Date(2025, 3, 7, 9, 0, 0, 0, time.UTC)
+		pr, err := prOps.UpdatePullRequest(context.Background(), "octo", "repo", 5, PullRequestUpdate{
+			Title:             &title,
+			Base:              &base,
+			Draft:             &draft,
+			ExpectedUpdatedAt: &expected,
+		})
+		if err != nil {
+			t.Fatalf("UpdatePullRequest() error = %v", err)
+		}
+
+		if edit["title"] != title || edit["base"] != base {
+			t.Errorf("edit request = %v, want title and base", edit)
+		}
+		if _, ok := edit["body"]; ok {
+			t.Errorf("edit request = %v, body must not be sent when unchanged", edit)
+		}
+		if !strings.Contains(graphQL.Query, "convertPullRequestToDraft") || graphQL.Variables["id"] != "PR_node5" {
+			t.Errorf("GraphQL request = %+v, want convertPullRequestToDraft for PR_node5", graphQL)
+		}
+		if !pr.GetDraft() {
+			t.Error("Draft = false, want the re-read pull request to be a draft")
+		}
+	})
+
+	t.Run("DraftToggleFailureSkipsEdit", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/repos/octo/repo/pulls/5":
+				fmt.Fprintf(w, prJSON, false)
+			case r.Method == http.MethodPost && r.URL.Path == "/graphql":
+				w.Write([]byte(`{"errors":[{"type":"FORBIDDEN","message":"Resource not accessible by integration"}]}`))
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+		prOps := NewPullRequestOperations(client, logrus.New())
+
+		title := "New title"
+		draft := true
+		_, err := prOps.UpdatePullRequest(context.Background(), "octo", "repo", 5, PullRequestUpdate{Title: &title, Draft: &draft})
+		if !errors.IsType(err, errors.ErrorTypePermission) {
+			t.Errorf("UpdatePullRequest() error = %v, want the permission error of the draft toggle", err)
+		}
+	})
+
+	t.Run("EditFailureReportsDraftToggle", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/repos/octo/repo/pulls/5":
+				fmt.Fprintf(w, prJSON, false)
+			case r.Method == http.MethodPost && r.URL.Path == "/graphql":
+				w.Write([]byte(`{"data":{"convertPullRequestToDraft":{"pullRequest":{"isDraft":true}}}}`))
+			case r.Method == http.MethodPatch && r.URL.Path == "/repos/octo/repo/pulls/5":
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"message":"Validation Failed","errors":[{"resource":"PullRequest","field":"base","code":"invalid"}]}`))
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+		prOps := NewPullRequestOperations(client, logrus.New())
+
+		base := "missing"
+		draft := true
+		_, err := prOps.UpdatePullRequest(context.Background(), "octo", "repo", 5, PullRequestUpdate{Base: &base, Draft: &draft})
+		if err == nil || !strings.Contains(err.Error(), "was converted to a draft, but the other changes failed") {
+			t.Errorf("UpdatePullRequest() error = %v, want it to report the applied draft conversion", err)
+		}
+	})
+
+	t.Run("RejectsStaleUpdate", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, prJSON, false)
+		})
+		prOps := NewPullRequestOperations(client, logrus.New())
+
+		title := "New title"
+		stale := time.Date(2025, 3, 7, 8, 0, 0, 0, time.UTC)
+		_, err := prOps.UpdatePullRequest(context.Background(), "octo", "repo", 5, PullRequestUpdate{
+			Title:             &title,
+			ExpectedUpdatedAt: &stale,
+		})
+		if !errors.IsType(err, errors.ErrorTypeConflict) {
+			t.Errorf("UpdatePullRequest() error = %v, want a conflict error", err)
+		}
+	})
+}
+
+func TestMergePullRequest(t *testing.T) {
+	const mergeablePR = `{"number": 5, "state": "open", "mergeable": true, "mergeable_state": "%s", "head": {"sha": "abc123"}, "base": {"ref": "main"}}`
+
+	newOps := func(t *testing.T, handler http.HandlerFunc) *PullRequestOperations {
+		prOps := NewPullRequestOperations(newTestClient(t, handler), logrus.New())
+		prOps.mergeablePollInterval = 0
+		return prOps
+	}
+
+	t.Run("WaitsForMergeableAndMerges", func(t *testing.T) {
+		gets := 0
+		var merge map[string]interface{}
+		prOps := newOps(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/repos/octo/repo/pulls/5":
+				gets++
+				if gets == 1 {
+					w.Write([]byte(`{"number": 5, "state": "open", "mergeable": null, "head": {"sha": "abc123"}}`))
+					return
+				}
+				fmt.Fprintf(w, mergeablePR, "clean")
+			case r.Method == http.MethodPut && r.URL.Path == "/repos/octo/repo/pulls/5/merge":
+				json.NewDecoder(r.Body).Decode(&merge)
+				w.Write([]byte(`{"sha": "def456", "merged": true, "message": "Pull Request successfully merged"}`))
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+
+		result, err := prOps.MergePullRequest(context.Background(), "octo", "repo", 5, MergePullRequestOptions{
+			MergeMethod:     "squash",
+			CommitTitle:     "Squashed",
+			ExpectedHeadSHA: "abc123",
+		})
+		if err != nil {
+			t.Fatalf("MergePullRequest() error = %v", err)
+		}
+		if gets != 2 {
+			t.Errorf("pull request fetched %d times, want 2", gets)
+		}
+		if merge["merge_method"] != "squash" || merge["sha"] != "abc123" || merge["commit_title"] != "Squashed" {
+			t.Errorf("merge request = %v", merge)
+		}
+		if !result.GetMerged() || result.GetSHA() != "def456" {
+			t.Errorf("result = %+v", result)
+		}
+	})
+
+	errorCases := []struct {
+		name      string
+		pr        string
+		mergeCode int
+		mergeBody string
+		opts      MergePullRequestOptions
+		wantType  string
+	}{
+		{
+			name:     "HeadMoved",
+			pr:       fmt.Sprintf(mergeablePR, "clean"),
+			opts:     MergePullRequestOptions{ExpectedHeadSHA: "000000"},
+			wantType: errors.ErrorTypeConflict,
+		},
+		{
+			name:     "MergeConflicts",
+			pr:       `{"number": 5, "state": "open", "mergeable": false, "mergeable_state": "dirty", "base": {"ref": "main"}}`,
+			wantType: errors.ErrorTypeConflict,
+		},
+		{
+			name:     "AlreadyMerged",
+			pr:       `{"number": 5, "state": "closed", "merged": true}`,
+			wantType: errors.ErrorTypeConflict,
+		},
+		{
+			name:      "ProtectionRules",
+			pr:        fmt.Sprintf(mergeablePR, "blocked"),
+			mergeCode: http.StatusMethodNotAllowed,
+			mergeBody: `{"message": "At least 1 approving review is required by reviewers with write access."}`,
+			wantType:  errors.ErrorTypePrecondition,
+		},
+		{
+			name:      "HeadModifiedDuringMerge",
+			pr:        fmt.Sprintf(mergeablePR, "clean"),
+			mergeCode: http.StatusConflict,
+			mergeBody: `{"message": "Head branch was modified. Review and try the merge again."}`,
+			wantType:  errors.ErrorTypeConflict,
+		},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			prOps := newOps(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodGet {
+					w.Write([]byte(tc.pr))
+					return
+				}
+				if tc.mergeCode == 0 {
+					t.Errorf("unexpected merge request")
+				}
+				w.WriteHeader(tc.mergeCode)
+				w.Write([]byte(tc.mergeBody))
+			})
+
+			_, err := prOps.MergePullRequest(context.Background(), "octo", "repo", 5, tc.opts)
+			if !errors.IsType(err, tc.wantType) {
+				t.Errorf("MergePullRequest() error = %v, want type %s", err, tc.wantType)
+			}
+		})
+	}
+}
+
+func TestListPullRequestFiles(t *testing.T) {
+	var query map[string][]string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/octo/repo/pulls/7/files" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{"filename": "pkg/a.go", "status": "modified", "additions": 2, "deletions": 1},
+			{"filename": "docs/b.md", "status": "added", "additions": 10},
+			{"filename": "pkg/c.go", "previous_filename": "internal/c.go", "status": "renamed"},
+			{"filename": "pkg/a_test.go", "status": "modified"}
+		]`))
+	})
+	prOps := NewPullRequestOperations(client, logrus.New())
+
+	result, err := prOps.ListPullRequestFiles(context.Background(), "octo", "repo", 7, PathFilter{
+		Include: []string{"internal/**", "pkg/a*.go"},
+		Exclude: []string{"*_test.go"},
+	}, PaginationOptions{Page: 1})
+	if err != nil {
+		t.Fatalf("ListPullRequestFiles() error = %v", err)
+	}
+
+	if got := query["per_page"]; len(got) != 1 || got[0] != "100" {
+		t.Errorf("per_page = %v, want 100 when path filters are set", got)
+	}
+	var names []string
+	for _, file := range result.Items {
+		names = append(names, file.GetFilename())
+	}
+	if strings.Join(names, ",") != "pkg/a.go,pkg/c.go" {
+		t.Errorf("files = %v, want pkg/a.go and the renamed pkg/c.go", names)
+	}
+
+	if _, err := prOps.ListPullRequestFiles(context.Background(), "octo", "repo", 7, PathFilter{Include: []string{"[a-"}}, PaginationOptions{}); !errors.IsType(err, errors.ErrorTypeValidation) {
+		t.Errorf("error = %v, want a validation error for a malformed glob", err)
+	}
+}
+
+func TestGetPullRequestStatus(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/repos/octo/repo/pulls/9":
+			w.Write([]byte(`{"number": 9, "state": "open", "mergeable": true, "mergeable_state": "blocked",
+				"head": {"sha": "abc123"}, "base": {"ref": "main"}}`))
+		case "/repos/octo/repo/commits/abc123/status":
+			w.Write([]byte(`{"state": "success", "total_count": 1, "statuses": [{"context": "ci/legacy", "state": "success"}]}`))
+		case "/repos/octo/repo/commits/abc123/check-runs":
+			if r.URL.Query().Get("filter") != "latest" {
+				t.Errorf("filter = %q, want latest", r.URL.Query().Get("filter"))
+			}
+			w.Write([]byte(`{"total_count": 3, "check_runs": [
+				{"name": "build", "status": "completed", "conclusion": "success"},
+				{"name": "lint", "status": "completed", "conclusion": "failure"},
+				{"name": "e2e", "status": "in_progress"}
+			]}`))
+		case "/repos/octo/repo/pulls/9/reviews":
+			w.Write([]byte(`[
+				{"user": {"login": "alice"}, "state": "CHANGES_REQUESTED"},
+				{"user": {"login": "alice"}, "state": "COMMENTED"},
+				{"user": {"login": "bob"}, "state": "APPROVED"},
+				{"user": {"login": "bob"}, "state": "DISMISSED"}
+			]`))
+		case "/graphql":
+			w.Write([]byte(`{"data": {"repository": {"pullRequest": {"reviewDecision": "CHANGES_REQUESTED"}}}}`))
+		case "/repos/octo/repo/compare/main...abc123":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "Not Found"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	prOps := NewPullRequestOperations(client, logrus.New())
+
+	status, err := prOps.GetPullRequestStatus(context.Background(), "octo", "repo", 9)
+	if err != nil {
+		t.Fatalf("GetPullRequestStatus() error = %v", err)
+	}
+
+	if passed, failed, pending := status.CheckRunCounts(); passed != 1 || failed != 1 || pending != 1 {
+		t.Errorf("CheckRunCounts() = %d, %d, %d, want 1, 1, 1", passed, failed, pending)
+	}
+	if len(status.LatestReviews) != 1 || status.LatestReviews[0].GetUser().GetLogin() != "alice" {
+		t.Errorf("LatestReviews = %v, want only alice's change request", status.LatestReviews)
+	}
+	if status.BehindBy != -1 || len(status.Warnings) != 1 || !strings.Contains(status.Warnings[0], "comparison with base") {
+		t.Errorf("BehindBy = %d, Warnings = %v, want an unknown behind-by count with a warning", status.BehindBy, status.Warnings)
+	}
+
+	want := []string{
+		"changes were requested by a reviewer",
+		"check runs are not passing (1 failed, 1 pending)",
+	}
+	if fmt.Sprint(status.Blockers) != fmt.Sprint(want) {
+		t.Errorf("Blockers = %q, want %q", status.Blockers, want)
+	}
+}
+
+func TestPullRequestStatusBehindBlocker(t *testing.T) {
+	behind := &github.PullRequest{State: github.String("open"), Mergeable: github.Bool(true), MergeableState: github.String("behind")}
+
+	status := &PullRequestStatus{PullRequest: behind, BehindBy: 3}
+	if got := status.blockers(); len(got) != 1 || got[0] != "the head branch is 3 commits behind the base branch, which must be up to date" {
+		t.Errorf("blockers() = %q, want the behind-by count", got)
+	}
+
+	status = &PullRequestStatus{PullRequest: behind, BehindBy: -1}
+	if got := status.blockers(); len(got) != 1 || got[0] != "the head branch is behind the base branch, which must be up to date" {
+		t.Errorf("blockers() = %q, want no count when it is unknown", got)
+	}
+}
+
+func TestUpdatePullRequestBranch(t *testing.T) {
+	var received map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/repos/octo/repo/pulls/3/update-branch" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&received)
+		w.Header().Set("Content-Type", "application/json")
+		if received["expected_head_sha"] == "stale" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"message": "expected head sha didn't match current head ref."}`))
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"message": "Updating pull request branch.", "url": "https://github.com/octo/repo/pull/3"}`))
+	})
+	prOps := NewPullRequestOperations(client, logrus.New())
+
+	message, err := prOps.UpdatePullRequestBranch(context.Background(), "octo", "repo", 3, "abc123")
+	if err != nil {
+		t.Fatalf("UpdatePullRequestBranch() error = %v", err)
+	}
+	if message != "Updating pull request branch." || received["expected_head_sha"] != "abc123" {
+		t.Errorf("message = %q, request = %v", message, received)
+	}
+
+	_, err = prOps.UpdatePullRequestBranch(context.Background(), "octo", "repo", 3, "stale")
+	if !errors.IsType(err, errors.ErrorTypeConflict) {
+		t.Errorf("error = %v, want a conflict when the head moved", err)
+	}
+}
+
+func TestEnableAutoMerge(t *testing.T) {
+	var mutation graphQLRequest
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(req.Query, "pullRequest(number: $number) { id }") {
+			w.Write([]byte(`{"data": {"repository": {"pullRequest": {"id": "PR_kw1"}}}}`))
+			return
+		}
+		mutation = req
+		w.Write([]byte(`{"data": {"enablePullRequestAutoMerge": {"pullRequest": {"autoMergeRequest": {
+			"enabledAt": "2025-03-20T10:00:00Z", "mergeMethod": "SQUASH", "enabledBy": {"login": "bot"}}}}}}`))
+	})
+	prOps := NewPullRequestOperations(client, logrus.New())
+
+	autoMerge, err := prOps.EnableAutoMerge(context.Background(), "octo", "repo", 3, AutoMergeOptions{
+		MergeMethod:     "squash",
+		CommitTitle:     "Add feature (#3)",
+		ExpectedHeadSHA: "abc123",
+	})
+	if err != nil {
+		t.Fatalf("EnableAutoMerge() error = %v", err)
+	}
+
+	input, _ := mutation.Variables["input"].(map[string]interface{})
+	if input["pullRequestId"] != "PR_kw1" || input["mergeMethod"] != "SQUASH" || input["commitHeadline"] != "Add feature (#3)" || input["expectedHeadOid"] != "abc123" {
+		t.Errorf("input = %v", input)
+	}
+	if _, ok := input["commitBody"]; ok {
+		t.Errorf("input = %v, want no commitBody", input)
+	}
+	if autoMerge.MergeMethod != "SQUASH" || autoMerge.EnabledBy.Login != "bot" {
+		t.Errorf("autoMerge = %+v", autoMerge)
+	}
+
+	if _, err := prOps.EnableAutoMerge(context.Background(), "octo", "repo", 3, AutoMergeOptions{MergeMethod: "fast-forward"}); !errors.IsType(err, errors.ErrorTypeValidation) {
+		t.Errorf("error = %v, want a validation error for an invalid merge method", err)
+	}
+}
+
+func TestEnableAutoMergeWithoutAutoMergeRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		merged     bool
+		wantMerged bool
+		wantErr    string
+	}{
+		{name: "MergedRightAway", merged: true, wantMerged: true},
+		{name: "NotMerged", merged: false, wantErr: errors.ErrorTypeConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodGet {
+					fmt.Fprintf(w, `{"number": 3, "state": "open", "merged": %t}`, tt.merged)
+					return
+				}
+				var req graphQLRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				if strings.Contains(req.Query, "pullRequest(number: $number) { id }") {
+					w.Write([]byte(`{"data": {"repository": {"pullRequest": {"id": "PR_kw1"}}}}`))
+					return
+				}
+				w.Write([]byte(`{"data": {"enablePullRequestAutoMerge": {"pullRequest": {"autoMergeRequest": null}}}}`))
+			})
+			prOps := NewPullRequestOperations(client, logrus.New())
+
+			autoMerge, err := prOps.EnableAutoMerge(context.Background(), "octo", "repo", 3, AutoMergeOptions{MergeMethod: "merge"})
+			if tt.wantErr != "" {
+				if !errors.IsType(err, tt.wantErr) {
+					t.Fatalf("error = %v, want a %s error", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || autoMerge != nil {
+				t.Errorf("EnableAutoMerge() = %+v, %v, want nil, nil for a merged pull request", autoMerge, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/sirupsen/logrus"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+)
+
+// Things that can be reacted to
+const (
+	// ReactionSubjectIssue is an issue or pull request, identified by its number
+	ReactionSubjectIssue = "issue"
+	// ReactionSubjectIssueComment is a comment on an issue or pull request conversation
+	ReactionSubjectIssueComment = "issue_comment"
+	// ReactionSubjectReviewComment is an inline pull request review comment
+	ReactionSubjectReviewComment = "review_comment"
+)
+
+// ReactionContents lists the reactions GitHub supports
+var ReactionContents = []string{"+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", "eyes"}
+
+// ReactionOperations handles reaction-related operations
+type ReactionOperations struct {
+	client *Client
+	logger *logrus.Logger
+}
+
+// NewReactionOperations creates a new ReactionOperations
+func NewReactionOperations(client *Client, logger *logrus.Logger) *ReactionOperations {
+	return &ReactionOperations{
+		client: client,
+		logger: logger,
+	}
+}
+
+// validateReactionTarget checks the common parameters of reaction operations
+func validateReactionTarget(owner, repo, subject string, id int64) error {
+	if owner == "" {
+		return errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return errors.NewValidationError("repo cannot be empty")
+	}
+	switch subject {
+	case ReactionSubjectIssue, ReactionSubjectIssueComment, ReactionSubjectReviewComment:
+	default:
+		return errors.NewValidationError(fmt.Sprintf("subject must be one of: %s, %s, %s", ReactionSubjectIssue, ReactionSubjectIssueComment, ReactionSubjectReviewComment))
+	}
+	if id <= 0 {
+		return errors.NewValidationError("id must be greater than 0")
+	}
+	return nil
+}
+
+// validateReactionContent checks that content is a supported reaction
+func validateReactionContent(content string) error {
+	for _, valid := range ReactionContents {
+		if content == valid {
+			return nil
+		}
+	}
+	return errors.NewValidationError(fmt.Sprintf("content must be one of: %s", strings.Join(ReactionContents, ", ")))
+}
+
+// ListReactions lists the reactions on an issue, pull request, issue comment or review comment.
+// For issues and pull requests, id is the number; for comments, it is the comment ID.
+// If content is set, only reactions of that kind are returned.
+func (r *ReactionOperations) ListReactions(ctx context.Context, owner, repo, subject string, id int64, content string, pagination PaginationOptions) (*ListResult[*github.Reaction], error) {
+	// Validate parameters
+	if err := validateReactionTarget(owner, repo, subject, id); err != nil {
+		return nil, err
+	}
+	if content != "" {
+		if err := validateReactionContent(content); err != nil {
+			return nil, err
+		}
+	}
+
+	var keep func(reaction *github.Reaction) bool
+	if content != "" {
+		keep = func(reaction *github.Reaction) bool {
+			return reaction.GetContent() == content
+		}
+	}
+
+	// List reactions
+	result, err := PaginateFiltered(ctx, pagination, 30, keep, func(listOpts github.ListOptions) ([]*github.Reaction, *github.Response, error) {
+		switch subject {
+		case ReactionSubjectIssue:
+			return r.client.GetClient().Reactions.ListIssueReactions(ctx, owner, repo, int(id), &listOpts)
+		case ReactionSubjectIssueComment:
+			return r.client.GetClient().Reactions.ListIssueCommentReactions(ctx, owner, repo, id, &listOpts)
+		default:
+			return r.client.GetClient().Reactions.ListPullRequestCommentReactions(ctx, owner, repo, id, &listOpts)
+		}
+	})
+	if err != nil {
+		return nil, r.client.HandleError(err)
+	}
+
+	return result, nil
+}
+
+// AddReaction adds a reaction as the authenticated user. Adding a reaction that already exists
+// returns the existing one.
+func (r *ReactionOperations) AddReaction(ctx context.Context, owner, repo, subject string, id int64, content string) (*github.Reaction, error) {
+	// Validate parameters
+	if err := validateReactionTarget(owner, repo, subject, id); err != nil {
+		return nil, err
+	}
+	if err := validateReactionContent(content); err != nil {
+		return nil, err
+	}
+
+	// Add reaction
+	var reaction *github.Reaction
+	var err error
+	switch subject {
+	case ReactionSubjectIssue:
+		reaction, _, err = r.client.GetClient().Reactions.CreateIssueReaction(ctx, owner, repo, int(id), content)
+	case ReactionSubjectIssueComment:
+		reaction, _, err = r.client.GetClient().Reactions.CreateIssueCommentReaction(ctx, owner, repo, id, content)
+	default:
+		reaction, _, err = r.client.GetClient().Reactions.CreatePullRequestCommentReaction(ctx, owner, repo, id, content)
+	}
+	if err != nil {
+		return nil, r.client.HandleError(err)
+	}
+
+	return reaction, nil
+}
+
+// RemoveReaction removes a reaction by its ID. If reactionID is 0, the authenticated user's
+// reaction with the given content is removed instead. It returns the removed reaction's ID.
+func (r *ReactionOperations) RemoveReaction(ctx context.Context, owner, repo, subject string, id, reactionID int64, content string) (int64, error) {
+	// Validate parameters
+	if err := validateReactionTarget(owner, repo, subject, id); err != nil {
+		return 0, err
+	}
+	if reactionID < 0 {
+		return 0, errors.NewValidationError("reaction_id must be greater than 0")
+	}
+	if reactionID == 0 {
+		if content == "" {
+			return 0, errors.NewValidationError("either reaction_id or content must be provided")
+		}
+		found, err := r.findOwnReaction(ctx, owner, repo, subject, id, content)
+		if err != nil {
+			return 0, err
+		}
+		reactionID = found
+	}
+
+	// Remove reaction
+	var err error
+	switch subject {
+	case ReactionSubjectIssue:
+		_, err = r.client.GetClient().Reactions.DeleteIssueReaction(ctx, owner, repo, int(id), reactionID)
+	case ReactionSubjectIssueComment:
+		_, err = r.client.GetClient().Reactions.DeleteIssueCommentReaction(ctx, owner, repo, id, reactionID)
+	default:
+		_, err = r.client.GetClient().Reactions.DeletePullRequestCommentReaction(ctx, owner, repo, id, reactionID)
+	}
+	if err != nil {
+		return 0, r.client.HandleError(err)
+	}
+
+	return reactionID, nil
+}
+
+// findOwnReaction returns the ID of the authenticated user's reaction with the given content
+func (r *ReactionOperations) findOwnReaction(ctx context.Context, owner, repo, subject string, id int64, content string) (int64, error) {
+	user, _, err := r.client.GetClient().Users.Get(ctx, "")
+	if err != nil {
+		return 0, r.client.HandleError(err)
+	}
+
+	reactions, err := r.ListReactions(ctx, owner, repo, subject, id, content, PaginationOptions{MaxItems: MaxItemsLimit})
+	if err != nil {
+		return 0, err
+	}
+	for _, reaction := range reactions.Items {
+		if reaction.GetUser().GetID() == user.GetID() {
+			return reaction.GetID(), nil
+		}
+	}
+
+	if reactions.Truncated {
+		return 0, errors.NewNotFoundError(fmt.Sprintf("%s has no %q reaction among the first %d reactions on %s %d; pass reaction_id instead", user.GetLogin(), content, MaxScanPages*MaxPerPage, subject, id))
+	}
+	return 0, errors.NewNotFoundError(fmt.Sprintf("%s has no %q reaction on %s %d", user.GetLogin(), content, subject, id))
+}
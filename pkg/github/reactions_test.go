@@ -0,0 +1,73 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestRemoveReactionByContent(t *testing.T) {
+	var deleted string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/user":
+			w.Write([]byte(`{"id": 7, "login": "bot"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/octo/repo/pulls/comments/9/reactions":
+			w.Write([]byte(`[
+				{"id": 100, "content": "rocket", "user": {"id": 1, "login": "alice"}},
+				{"id": 101, "content": "eyes", "user": {"id": 7, "login": "bot"}},
+				{"id": 102, "content": "rocket", "user": {"id": 7, "login": "bot"}}
+			]`))
+		case r.Method == http.MethodDelete:
+			deleted = r.URL.Path
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	reactionOps := NewReactionOperations(client, logrus.New())
+
+	removed, err := reactionOps.RemoveReaction(context.Background(), "octo", "repo", ReactionSubjectReviewComment, 9, 0, "rocket")
+	if err != nil {
+		t.Fatalf("RemoveReaction() error = %v", err)
+	}
+	if removed != 102 || deleted != "/repos/octo/repo/pulls/comments/9/reactions/102" {
+		t.Errorf("removed = %d via %s, want the bot's rocket reaction 102", removed, deleted)
+	}
+
+	if _, err := reactionOps.RemoveReaction(context.Background(), "octo", "repo", ReactionSubjectReviewComment, 9, 0, "heart"); err == nil {
+		t.Error("RemoveReaction() error = nil, want not found for a reaction the user did not add")
+	}
+}
+
+func TestListReactionsFiltersBeforeLimiting(t *testing.T) {
+	var serverURL string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			w.Write([]byte(`[{"id": 200, "content": "rocket", "user": {"id": 7, "login": "bot"}}]`))
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s%s?page=2&per_page=100>; rel="next"`, serverURL, r.URL.Path))
+		w.Write([]byte(`[
+			{"id": 100, "content": "heart", "user": {"id": 1, "login": "alice"}},
+			{"id": 101, "content": "rocket", "user": {"id": 1, "login": "alice"}},
+			{"id": 102, "content": "heart", "user": {"id": 2, "login": "bob"}}
+		]`))
+	})
+	serverURL = strings.TrimSuffix(client.GetClient().BaseURL.String(), "/")
+	reactionOps := NewReactionOperations(client, logrus.New())
+
+	result, err := reactionOps.ListReactions(context.Background(), "octo", "repo", ReactionSubjectIssue, 4, "rocket", PaginationOptions{MaxItems: 2})
+	if err != nil {
+		t.Fatalf("ListReactions() error = %v", err)
+	}
+	if len(result.Items) != 2 || result.Items[0].GetID() != 101 || result.Items[1].GetID() != 200 {
+		t.Errorf("reactions = %v, want the rocket reactions of both pages", result.Items)
+	}
+}
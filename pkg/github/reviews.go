@@ -0,0 +1,715 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/sirupsen/logrus"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+)
+
+// ReviewOperations handles pull request review-related operations
+type ReviewOperations struct {
+	client *Client
+	logger *logrus.Logger
+}
+
+// NewReviewOperations creates a new ReviewOperations
+func NewReviewOperations(client *Client, logger *logrus.Logger) *ReviewOperations {
+	return &ReviewOperations{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ReviewComment is an inline comment of a new review.
+// Line (and StartLine for multi-line comments) refer to lines of the file on the given side of the diff.
+type ReviewComment struct {
+	Path      string `json:"path"`
+	Body      string `json:"body"`
+	Line      int    `json:"line"`
+	Side      string `json:"side,omitempty"`
+	StartLine int    `json:"start_line,omitempty"`
+	StartSide string `json:"start_side,omitempty"`
+	// Suggestion is appended to the body as a suggested change replacing the commented lines
+	Suggestion *string `json:"suggestion,omitempty"`
+}
+
+// ReviewThread is a root review comment and its replies
+type ReviewThread struct {
+	Path      string
+	Line      int
+	StartLine int
+	Side      string
+	// Outdated is true if the commented lines are no longer part of the diff
+	Outdated bool
+	Comments []*github.PullRequestComment
+}
+
+// PullRequestReviews holds the reviews of a pull request and its review comments grouped into threads
+type PullRequestReviews struct {
+	Reviews []*github.PullRequestReview
+	Threads []*ReviewThread
+	// Truncated is true if not all reviews or comments were fetched
+	Truncated bool
+}
+
+// CreateReview creates and submits a review with optional inline comments
+func (r *ReviewOperations) CreateReview(ctx context.Context, owner, repo string, number int, event, body, commitID string, comments []ReviewComment) (*github.PullRequestReview, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+	switch event {
+	case "APPROVE":
+	case "REQUEST_CHANGES":
+		if body == "" {
+			return nil, errors.NewValidationError("body is required when requesting changes")
+		}
+	case "COMMENT":
+		if body == "" && len(comments) == 0 {
+			return nil, errors.NewValidationError("body or comments are required for a COMMENT review")
+		}
+	default:
+		return nil, errors.NewValidationError("event must be one of: APPROVE, REQUEST_CHANGES, COMMENT")
+	}
+
+	draftComments := make([]*github.DraftReviewComment, 0, len(comments))
+	for i, comment := range comments {
+		draft, err := comment.toDraftReviewComment()
+		if err != nil {
+			return nil, errors.NewValidationError(fmt.Sprintf("comments[%d]: %s", i, err.Message))
+		}
+		draftComments = append(draftComments, draft)
+	}
+
+	review := &github.PullRequestReviewRequest{
+		Event:    github.String(event),
+		Comments: draftComments,
+	}
+	if body != "" {
+		review.Body = github.String(body)
+	}
+	if commitID != "" {
+		review.CommitID = github.String(commitID)
+	}
+
+	// Create review
+	result, _, err := r.client.GetClient().PullRequests.CreateReview(ctx, owner, repo, number, review)
+	if err != nil {
+		return nil, r.client.HandleError(err)
+	}
+
+	return result, nil
+}
+
+// toDraftReviewComment validates the comment and converts it to the API representation
+func (c ReviewComment) toDraftReviewComment() (*github.DraftReviewComment, *errors.GitHubError) {
+	if c.Path == "" {
+		return nil, errors.NewValidationError("path cannot be empty")
+	}
+	if c.Line <= 0 {
+		return nil, errors.NewValidationError("line must be greater than 0")
+	}
+	if c.Body == "" && c.Suggestion == nil {
+		return nil, errors.NewValidationError("body or suggestion is required")
+	}
+
+	side := strings.ToUpper(c.Side)
+	if side == "" {
+		side = "RIGHT"
+	}
+	if side != "LEFT" && side != "RIGHT" {
+		return nil, errors.NewValidationError("side must be either LEFT or RIGHT")
+	}
+
+	body := c.Body
+	if c.Suggestion != nil {
+		if side == "LEFT" {
+			return nil, errors.NewValidationError("suggestions can only be made on the RIGHT side")
+		}
+		body = strings.TrimRight(body, "\n")
+		if body != "" {
+			body += "\n\n"
+		}
+		body += "```suggestion\n" + *c.Suggestion + "\n```"
+	}
+
+	draft := &github.DraftReviewComment{
+		Path: github.String(c.Path),
+		Body: github.String(body),
+		Line: github.Int(c.Line),
+		Side: github.String(side),
+	}
+
+	if c.StartLine > 0 {
+		startSide := strings.ToUpper(c.StartSide)
+		if startSide == "" {
+			startSide = side
+		}
+		if startSide != "LEFT" && startSide != "RIGHT" {
+			return nil, errors.NewValidationError("start_side must be either LEFT or RIGHT")
+		}
+		if startSide == side && c.StartLine >= c.Line {
+			return nil, errors.NewValidationError("start_line must be less than line")
+		}
+		draft.StartLine = github.Int(c.StartLine)
+		draft.StartSide = github.String(startSide)
+	}
+
+	return draft, nil
+}
+
+// GetReviews gets the reviews of a pull request and its review comments grouped into threads
+func (r *ReviewOperations) GetReviews(ctx context.Context, owner, repo string, number int) (*PullRequestReviews, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+
+	pagination := PaginationOptions{MaxItems: MaxItemsLimit}
+
+	// List reviews
+	reviews, err := Paginate(ctx, pagination, MaxPerPage, func(listOpts github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
+		return r.client.GetClient().PullRequests.ListReviews(ctx, owner, repo, number, &listOpts)
+	})
+	if err != nil {
+		return nil, r.client.HandleError(err)
+	}
+
+	// List review comments
+	opts := &github.PullRequestListCommentsOptions{
+		Sort:      "created",
+		Direction: "asc",
+	}
+	comments, err := Paginate(ctx, pagination, MaxPerPage, func(listOpts github.ListOptions) ([]*github.PullRequestComment, *github.Response, error) {
+		opts.ListOptions = listOpts
+		return r.client.GetClient().PullRequests.ListComments(ctx, owner, repo, number, opts)
+	})
+	if err != nil {
+		return nil, r.client.HandleError(err)
+	}
+
+	return &PullRequestReviews{
+		Reviews:   reviews.Items,
+		Threads:   groupReviewThreads(comments.Items),
+		Truncated: reviews.Truncated || comments.Truncated,
+	}, nil
+}
+
+// groupReviewThreads groups review comments into threads by following in_reply_to_id.
+// Threads are ordered by the creation of their root comment.
+func groupReviewThreads(comments []*github.PullRequestComment) []*ReviewThread {
+	threadsByRoot := make(map[int64]*ReviewThread)
+	var threads []*ReviewThread
+
+	// Root comments start a thread
+	for _, comment := range comments {
+		if comment.InReplyTo != nil {
+			continue
+		}
+		thread := &ReviewThread{
+			Path:      comment.GetPath(),
+			Line:      comment.GetLine(),
+			StartLine: comment.GetStartLine(),
+			Side:      comment.GetSide(),
+			Outdated:  comment.Line == nil,
+			Comments:  []*github.PullRequestComment{comment},
+		}
+		if thread.Outdated {
+			thread.Line = comment.GetOriginalLine()
+			thread.StartLine = comment.GetOriginalStartLine()
+		}
+		threadsByRoot[comment.GetID()] = thread
+		threads = append(threads, thread)
+	}
+
+	// Replies always reference the root comment of their thread
+	for _, comment := range comments {
+		if comment.InReplyTo == nil {
+			continue
+		}
+		thread, ok := threadsByRoot[comment.GetInReplyTo()]
+		if !ok {
+			// The root comment was not fetched; keep the reply as its own thread
+			thread = &ReviewThread{
+				Path: comment.GetPath(),
+				Line: comment.GetLine(),
+				Side: comment.GetSide(),
+			}
+			threadsByRoot[comment.GetInReplyTo()] = thread
+			threads = append(threads, thread)
+		}
+		thread.Comments = append(thread.Comments, comment)
+	}
+
+	for _, thread := range threads {
+		sort.SliceStable(thread.Comments, func(i, j int) bool {
+			return thread.Comments[i].GetCreatedAt().Before(thread.Comments[j].GetCreatedAt().Time)
+		})
+	}
+	sort.SliceStable(threads, func(i, j int) bool {
+		return threads[i].Comments[0].GetCreatedAt().Before(threads[j].Comments[0].GetCreatedAt().Time)
+	})
+
+	return threads
+}
+
+// maxReviewThreadPages caps the number of review thread pages fetched via GraphQL
+const maxReviewThreadPages = 10
+
+// ReviewThreadComment is a comment of a review thread as returned by the GraphQL API
+type ReviewThreadComment struct {
+	ID         string    `json:"id"`
+	DatabaseID int64     `json:"databaseId"`
+	Body       string    `json:"body"`
+	URL        string    `json:"url"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Author     struct {
+		Login string `json:"login"`
+	} `json:"author"`
+}
+
+// GraphQLReviewThread is a review thread as returned by the GraphQL API, including its resolution state
+type GraphQLReviewThread struct {
+	ID         string `json:"id"`
+	IsResolved bool   `json:"isResolved"`
+	IsOutdated bool   `json:"isOutdated"`
+	Path       string `json:"path"`
+	Line       int    `json:"line"`
+	StartLine  int    `json:"startLine"`
+	DiffSide   string `json:"diffSide"`
+	ResolvedBy *struct {
+		Login string `json:"login"`
+	} `json:"resolvedBy"`
+	Comments struct {
+		TotalCount int                    `json:"totalCount"`
+		PageInfo   graphQLPageInfo        `json:"pageInfo"`
+		Nodes      []*ReviewThreadComment `json:"nodes"`
+	} `json:"comments"`
+}
+
+// graphQLPageInfo is the pageInfo of a GraphQL connection
+type graphQLPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// ReviewThreadList holds the review threads of a pull request
+type ReviewThreadList struct {
+	Threads []*GraphQLReviewThread
+	// Total is the number of threads of the pull request, including resolved ones
+	Total int
+	// Truncated is true if not all threads were fetched
+	Truncated bool
+}
+
+const reviewThreadsQuery = `query($owner: String!, $repo: String!, $number: Int!, $cursor: String) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) {
+      reviewThreads(first: 100, after: $cursor) {
+        totalCount
+        pageInfo { hasNextPage endCursor }
+        nodes {
+          id isResolved isOutdated path line startLine diffSide
+          resolvedBy { login }
+          comments(first: 100) {
+            totalCount
+            pageInfo { hasNextPage endCursor }
+            nodes { id databaseId body url createdAt author { login } }
+          }
+        }
+      }
+    }
+  }
+}`
+
+const reviewThreadCommentsQuery = `query($id: ID!, $cursor: String) {
+  node(id: $id) {
+    ... on PullRequestReviewThread {
+      comments(first: 100, after: $cursor) {
+        pageInfo { hasNextPage endCursor }
+        nodes { id databaseId body url createdAt author { login } }
+      }
+    }
+  }
+}`
+
+// ListReviewThreads lists the review threads of a pull request. Resolved threads are skipped unless includeResolved is set.
+func (r *ReviewOperations) ListReviewThreads(ctx context.Context, owner, repo string, number int, includeResolved bool) (*ReviewThreadList, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+
+	result := &ReviewThreadList{}
+	var cursor *string
+	for page := 0; ; page++ {
+		if page == maxReviewThreadPages {
+			result.Truncated = true
+			return result, nil
+		}
+
+		data, err := GraphQLQuery[struct {
+			Repository struct {
+				PullRequest *struct {
+					ReviewThreads struct {
+						TotalCount int                    `json:"totalCount"`
+						PageInfo   graphQLPageInfo        `json:"pageInfo"`
+						Nodes      []*GraphQLReviewThread `json:"nodes"`
+					} `json:"reviewThreads"`
+				} `json:"pullRequest"`
+			} `json:"repository"`
+		}](ctx, r.client.GraphQL(), reviewThreadsQuery, map[string]interface{}{
+			"owner":  owner,
+			"repo":   repo,
+			"number": number,
+			"cursor": cursor,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if data.Repository.PullRequest == nil {
+			return nil, errors.NewNotFoundError(fmt.Sprintf("pull request #%d not found in %s/%s", number, owner, repo))
+		}
+
+		threads := data.Repository.PullRequest.ReviewThreads
+		result.Total = threads.TotalCount
+		for _, thread := range threads.Nodes {
+			if includeResolved || !thread.IsResolved {
+				if err := r.listRemainingThreadComments(ctx, thread); err != nil {
+					return nil, err
+				}
+				result.Threads = append(result.Threads, thread)
+			}
+		}
+
+		if !threads.PageInfo.HasNextPage {
+			return result, nil
+		}
+		cursor = &threads.PageInfo.EndCursor
+	}
+}
+
+// listRemainingThreadComments fetches the comments of a review thread that did not fit into the first page.
+// At most maxReviewThreadPages further pages are fetched; Comments.TotalCount tells whether comments are still missing.
+func (r *ReviewOperations) listRemainingThreadComments(ctx context.Context, thread *GraphQLReviewThread) error {
+	for page := 0; thread.Comments.PageInfo.HasNextPage && page < maxReviewThreadPages; page++ {
+		data, err := GraphQLQuery[struct {
+			Node *struct {
+				Comments struct {
+					PageInfo graphQLPageInfo        `json:"pageInfo"`
+					Nodes    []*ReviewThreadComment `json:"nodes"`
+				} `json:"comments"`
+			} `json:"node"`
+		}](ctx, r.client.GraphQL(), reviewThreadCommentsQuery, map[string]interface{}{
+			"id":     thread.ID,
+			"cursor": thread.Comments.PageInfo.EndCursor,
+		})
+		if err != nil {
+			return err
+		}
+		if data.Node == nil {
+			return errors.NewNotFoundError(fmt.Sprintf("review thread %s not found", thread.ID))
+		}
+
+		thread.Comments.Nodes = append(thread.Comments.Nodes, data.Node.Comments.Nodes...)
+		thread.Comments.PageInfo = data.Node.Comments.PageInfo
+	}
+	return nil
+}
+
+// ReplyToReviewThread adds a reply to a review thread
+func (r *ReviewOperations) ReplyToReviewThread(ctx context.Context, threadID, body string) (*ReviewThreadComment, error) {
+	// Validate parameters
+	if threadID == "" {
+		return nil, errors.NewValidationError("thread_id cannot be empty")
+	}
+	if body == "" {
+		return nil, errors.NewValidationError("body cannot be empty")
+	}
+
+	data, err := GraphQLMutate[struct {
+		AddPullRequestReviewThreadReply struct {
+			Comment *ReviewThreadComment `json:"comment"`
+		} `json:"addPullRequestReviewThreadReply"`
+	}](ctx, r.client.GraphQL(), `mutation($threadId: ID!, $body: String!) {
+  addPullRequestReviewThreadReply(input: {pullRequestReviewThreadId: $threadId, body: $body}) {
+    comment { id databaseId body url createdAt author { login } }
+  }
+}`, map[string]interface{}{
+		"threadId": threadID,
+		"body":     body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if data.AddPullRequestReviewThreadReply.Comment == nil {
+		return nil, errors.NewNotFoundError(fmt.Sprintf("review thread %s not found", threadID))
+	}
+
+	return data.AddPullRequestReviewThreadReply.Comment, nil
+}
+
+// SetReviewThreadResolved resolves or unresolves a review thread
+func (r *ReviewOperations) SetReviewThreadResolved(ctx context.Context, threadID string, resolved bool) (*GraphQLReviewThread, error) {
+	// Validate parameters
+	if threadID == "" {
+		return nil, errors.NewValidationError("thread_id cannot be empty")
+	}
+
+	mutation := "unresolveReviewThread"
+	if resolved {
+		mutation = "resolveReviewThread"
+	}
+
+	data, err := GraphQLMutate[map[string]struct {
+		Thread *GraphQLReviewThread `json:"thread"`
+	}](ctx, r.client.GraphQL(), fmt.Sprintf(`mutation($threadId: ID!) {
+  %s(input: {threadId: $threadId}) {
+    thread { id isResolved isOutdated path line startLine diffSide resolvedBy { login } }
+  }
+}`, mutation), map[string]interface{}{
+		"threadId": threadID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	thread := (*data)[mutation].Thread
+	if thread == nil {
+		return nil, errors.NewNotFoundError(fmt.Sprintf("review thread %s not found", threadID))
+	}
+
+	return thread, nil
+}
+
+// RequestReviewers requests reviews from users and teams (team slugs, without the organization)
+func (r *ReviewOperations) RequestReviewers(ctx context.Context, owner, repo string, number int, reviewers, teamReviewers []string) (*github.PullRequest, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+	if len(reviewers) == 0 && len(teamReviewers) == 0 {
+		return nil, errors.NewValidationError("at least one reviewer or team reviewer is required")
+	}
+
+	// Request reviewers
+	pr, _, err := r.client.GetClient().PullRequests.RequestReviewers(ctx, owner, repo, number, github.ReviewersRequest{
+		Reviewers:     reviewers,
+		TeamReviewers: teamReviewers,
+	})
+	if err != nil {
+		return nil, r.client.HandleError(err)
+	}
+
+	return pr, nil
+}
+
+// RemoveReviewers removes review requests from users and teams and returns the remaining requested reviewers
+func (r *ReviewOperations) RemoveReviewers(ctx context.Context, owner, repo string, number int, reviewers, teamReviewers []string) (*github.Reviewers, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+	if len(reviewers) == 0 && len(teamReviewers) == 0 {
+		return nil, errors.NewValidationError("at least one reviewer or team reviewer is required")
+	}
+
+	// Remove reviewers
+	_, err := r.client.GetClient().PullRequests.RemoveReviewers(ctx, owner, repo, number, github.ReviewersRequest{
+		Reviewers:     reviewers,
+		TeamReviewers: teamReviewers,
+	})
+	if err != nil {
+		return nil, r.client.HandleError(err)
+	}
+
+	return r.ListRequestedReviewers(ctx, owner, repo, number)
+}
+
+// ListRequestedReviewers lists the users and teams whose review is requested and still pending
+func (r *ReviewOperations) ListRequestedReviewers(ctx context.Context, owner, repo string, number int) (*github.Reviewers, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+
+	// List requested reviewers; users and teams share the pages of the endpoint
+	result := &github.Reviewers{}
+	listOpts := &github.ListOptions{PerPage: MaxPerPage}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		reviewers, resp, err := r.client.GetClient().PullRequests.ListReviewers(ctx, owner, repo, number, listOpts)
+		if err != nil {
+			return nil, r.client.HandleError(err)
+		}
+		result.Users = append(result.Users, reviewers.Users...)
+		result.Teams = append(result.Teams, reviewers.Teams...)
+
+		if resp == nil || resp.NextPage == 0 {
+			return result, nil
+		}
+		listOpts.Page = resp.NextPage
+	}
+}
+
+// CodeOwnerSuggestion is a code owner of some of the files changed by a pull request
+type CodeOwnerSuggestion struct {
+	// Owner is "@user" or "@org/team"
+	Owner string
+	Files []string
+}
+
+// ReviewerSuggestions are the reviewers suggested for a pull request by the CODEOWNERS file
+type ReviewerSuggestions struct {
+	CodeOwnersPath string
+	Suggestions    []CodeOwnerSuggestion
+	// UnownedFiles are changed files no CODEOWNERS rule assigns an owner to
+	UnownedFiles []string
+}
+
+// Users returns the logins of the suggested users
+func (s *ReviewerSuggestions) Users() []string {
+	var users []string
+	for _, suggestion := range s.Suggestions {
+		if !strings.Contains(suggestion.Owner, "/") {
+			users = append(users, strings.TrimPrefix(suggestion.Owner, "@"))
+		}
+	}
+	return users
+}
+
+// TeamSlugs returns the slugs of the suggested teams of an organization; only those can be requested as reviewers
+func (s *ReviewerSuggestions) TeamSlugs(org string) []string {
+	var teams []string
+	for _, suggestion := range s.Suggestions {
+		if teamOrg, slug, ok := strings.Cut(strings.TrimPrefix(suggestion.Owner, "@"), "/"); ok && strings.EqualFold(teamOrg, org) {
+			teams = append(teams, slug)
+		}
+	}
+	return teams
+}
+
+// SuggestReviewers matches the files changed by a pull request against the CODEOWNERS file of its base branch.
+// The pull request author and owners given as email addresses are not suggested.
+func (r *ReviewOperations) SuggestReviewers(ctx context.Context, owner, repo string, number int) (*ReviewerSuggestions, error) {
+	// Validate parameters
+	if owner == "" {
+		return nil, errors.NewValidationError("owner cannot be empty")
+	}
+	if repo == "" {
+		return nil, errors.NewValidationError("repo cannot be empty")
+	}
+	if number <= 0 {
+		return nil, errors.NewValidationError("number must be greater than 0")
+	}
+
+	pr, _, err := r.client.GetClient().PullRequests.Get(ctx, owner, repo, number)
+	if err != nil {
+		return nil, r.client.HandleError(err)
+	}
+
+	// Find the CODEOWNERS file of the base branch
+	fileOps := NewFileOperations(r.client, r.logger)
+	suggestions := &ReviewerSuggestions{}
+	var rules []CodeOwnersRule
+	for _, path := range CodeOwnersPaths {
+		contents, err := fileOps.GetFileContents(ctx, owner, repo, path, pr.GetBase().GetRef())
+		if errors.IsType(err, errors.ErrorTypeNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		file, ok := contents.(*github.RepositoryContent)
+		if !ok {
+			continue
+		}
+		content, err := fileOps.DecodeFileContent(file)
+		if err != nil {
+			return nil, err
+		}
+		suggestions.CodeOwnersPath = path
+		rules = ParseCodeOwners(content)
+		break
+	}
+	if suggestions.CodeOwnersPath == "" {
+		return nil, errors.NewNotFoundError(fmt.Sprintf("no CODEOWNERS file found on branch %s (looked in %s)",
+			pr.GetBase().GetRef(), strings.Join(CodeOwnersPaths, ", ")))
+	}
+
+	files, err := NewPullRequestOperations(r.client, r.logger).ListPullRequestFiles(ctx, owner, repo, number, PathFilter{}, PaginationOptions{MaxItems: MaxItemsLimit})
+	if err != nil {
+		return nil, err
+	}
+
+	// Group the changed files by owner, in order of first appearance
+	author := strings.ToLower("@" + pr.GetUser().GetLogin())
+	byOwner := make(map[string]int)
+	for _, file := range files.Items {
+		owners := CodeOwnersFor(rules, file.GetFilename())
+		if len(owners) == 0 {
+			suggestions.UnownedFiles = append(suggestions.UnownedFiles, file.GetFilename())
+			continue
+		}
+		for _, codeOwner := range owners {
+			if !strings.HasPrefix(codeOwner, "@") || strings.ToLower(codeOwner) == author {
+				continue
+			}
+			i, ok := byOwner[codeOwner]
+			if !ok {
+				i = len(suggestions.Suggestions)
+				byOwner[codeOwner] = i
+				suggestions.Suggestions = append(suggestions.Suggestions, CodeOwnerSuggestion{Owner: codeOwner})
+			}
+			suggestions.Suggestions[i].Files = append(suggestions.Suggestions[i].Files, file.GetFilename())
+		}
+	}
+
+	return suggestions, nil
+}
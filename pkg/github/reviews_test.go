@@ -0,0 +1,377 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/sirupsen/logrus"
+)
+
+func TestReviewCommentToDraft(t *testing.T) {
+	suggestion := "return nil"
+
+	testCases := []struct {
+		name      string
+		comment   ReviewComment
+		wantBody  string
+		wantSide  string
+		wantStart int
+		wantErr   bool
+	}{
+		{
+			name:     "SingleLineDefaultsToRight",
+			comment:  ReviewComment{Path: "main.go", Line: 10, Body: "Nit"},
+			wantBody: "Nit",
+			wantSide: "RIGHT",
+		},
+		{
+			name:      "MultiLineWithSuggestion",
+			comment:   ReviewComment{Path: "main.go", Line: 12, StartLine: 10, Body: "Simplify:\n", Suggestion: &suggestion},
+			wantBody:  "Simplify:\n\n```suggestion\nreturn nil\n```",
+			wantSide:  "RIGHT",
+			wantStart: 10,
+		},
+		{
+			name:     "SuggestionOnly",
+			comment:  ReviewComment{Path: "main.go", Line: 3, Suggestion: &suggestion},
+			wantBody: "```suggestion\nreturn nil\n```",
+			wantSide: "RIGHT",
+		},
+		{
+			name:     "LeftSide",
+			comment:  ReviewComment{Path: "main.go", Line: 3, Side: "left", Body: "Why was this removed?"},
+			wantBody: "Why was this removed?",
+			wantSide: "LEFT",
+		},
+		{name: "MissingPath", comment: ReviewComment{Line: 1, Body: "x"}, wantErr: true},
+		{name: "MissingLine", comment: ReviewComment{Path: "main.go", Body: "x"}, wantErr: true},
+		{name: "MissingBody", comment: ReviewComment{Path: "main.go", Line: 1}, wantErr: true},
+		{name: "InvalidSide", comment: ReviewComment{Path: "main.go", Line: 1, Side: "UP", Body: "x"}, wantErr: true},
+		{name: "StartAfterLine", comment: ReviewComment{Path: "main.go", Line: 5, StartLine: 5, Body: "x"}, wantErr: true},
+		{name: "SuggestionOnLeft", comment: ReviewComment{Path: "main.go", Line: 5, Side: "LEFT", Suggestion: &suggestion}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			draft, err := tc.comment.toDraftReviewComment()
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected a validation error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("toDraftReviewComment() error = %v", err)
+			}
+			if draft.GetBody() != tc.wantBody {
+				t.Errorf("Body = %q, want %q", draft.GetBody(), tc.wantBody)
+			}
+			if draft.GetSide() != tc.wantSide {
+				t.Errorf("Side = %q, want %q", draft.GetSide(), tc.wantSide)
+			}
+			if draft.GetStartLine() != tc.wantStart {
+				t.Errorf("StartLine = %d, want %d", draft.GetStartLine(), tc.wantStart)
+			}
+		})
+	}
+}
+
+func TestCreateReview(t *testing.T) {
+	var received map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/repos/octo/repo/pulls/5/reviews" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&received)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 80, "state": "CHANGES_REQUESTED"}`))
+	})
+	reviewOps := NewReviewOperations(client, logrus.New())
+
+	_, err := reviewOps.CreateReview(context.Background(), "octo", "repo", 5, "REQUEST_CHANGES", "Please fix", "", []ReviewComment{
+		{Path: "main.go", Line: 12, StartLine: 10, Body: "Off by one"},
+	})
+	if err != nil {
+		t.Fatalf("CreateReview() error = %v", err)
+	}
+
+	if received["event"] != "REQUEST_CHANGES" || received["body"] != "Please fix" {
+		t.Errorf("request = %v", received)
+	}
+	comments, _ := received["comments"].([]interface{})
+	if len(comments) != 1 {
+		t.Fatalf("comments = %v, want one comment", received["comments"])
+	}
+	comment := comments[0].(map[string]interface{})
+	if comment["line"] != float64(12) || comment["start_line"] != float64(10) || comment["side"] != "RIGHT" || comment["start_side"] != "RIGHT" {
+		t.Errorf("comment = %v", comment)
+	}
+
+	// Validation happens before any request is made
+	if _, err := reviewOps.CreateReview(context.Background(), "octo", "repo", 5, "REQUEST_CHANGES", "", "", nil); err == nil {
+		t.Error("expected an error when requesting changes without a body")
+	}
+}
+
+func TestGroupReviewThreads(t *testing.T) {
+	at := func(minute int) *github.Timestamp {
+		return &github.Timestamp{Time: time.Date(2025, 3, 7, 10, minute, 0, 0, time.UTC)}
+	}
+
+	comments := []*github.PullRequestComment{
+		{ID: github.Int64(1), Path: github.String("a.go"), Line: github.Int(3), Side: github.String("RIGHT"), CreatedAt: at(1)},
+		{ID: github.Int64(2), Path: github.String("b.go"), OriginalLine: github.Int(7), CreatedAt: at(2)},
+		{ID: github.Int64(3), InReplyTo: github.Int64(1), Path: github.String("a.go"), Line: github.Int(3), CreatedAt: at(3)},
+		{ID: github.Int64(4), InReplyTo: github.Int64(99), Path: github.String("c.go"), Line: github.Int(1), CreatedAt: at(4)},
+	}
+
+	threads := groupReviewThreads(comments)
+	if len(threads) != 3 {
+		t.Fatalf("got %d threads, want 3", len(threads))
+	}
+
+	if len(threads[0].Comments) != 2 || threads[0].Comments[1].GetID() != 3 {
+		t.Errorf("first thread = %+v, want comment 1 with reply 3", threads[0])
+	}
+	if !threads[1].Outdated || threads[1].Line != 7 {
+		t.Errorf("second thread = %+v, want an outdated thread on original line 7", threads[1])
+	}
+	if threads[2].Path != "c.go" || len(threads[2].Comments) != 1 {
+		t.Errorf("third thread = %+v, want the orphaned reply", threads[2])
+	}
+}
+
+func TestListReviewThreads(t *testing.T) {
+	var cursors []interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		cursors = append(cursors, req.Variables["cursor"])
+
+		w.Header().Set("Content-Type", "application/json")
+		if req.Variables["cursor"] == nil {
+			w.Write([]byte(`{"data":{"repository":{"pullRequest":{"reviewThreads":{
+				"totalCount": 3,
+				"pageInfo": {"hasNextPage": true, "endCursor": "c1"},
+				"nodes": [
+					{"id": "PRRT_1", "isResolved": false, "path": "a.go", "line": 3, "comments": {"totalCount": 1, "nodes": [{"body": "Fix", "author": {"login": "alice"}}]}},
+					{"id": "PRRT_2", "isResolved": true, "path": "b.go", "line": 4, "comments": {"totalCount": 0, "nodes": []}}
+				]}}}}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"repository":{"pullRequest":{"reviewThreads":{
+			"totalCount": 3,
+			"pageInfo": {"hasNextPage": false, "endCursor": "c2"},
+			"nodes": [{"id": "PRRT_3", "isResolved": false, "path": "c.go", "line": 5, "comments": {"totalCount": 0, "nodes": []}}]
+		}}}}}`))
+	})
+	reviewOps := NewReviewOperations(client, logrus.New())
+
+	result, err := reviewOps.ListReviewThreads(context.Background(), "octo", "repo", 5, false)
+	if err != nil {
+		t.Fatalf("ListReviewThreads() error = %v", err)
+	}
+
+	if len(cursors) != 2 || cursors[1] != "c1" {
+		t.Errorf("cursors = %v, want [nil c1]", cursors)
+	}
+	if result.Total != 3 || len(result.Threads) != 2 || result.Threads[0].ID != "PRRT_1" || result.Threads[1].ID != "PRRT_3" {
+		t.Errorf("result = %+v, want the two unresolved threads", result)
+	}
+	if result.Threads[0].Comments.Nodes[0].Author.Login != "alice" {
+		t.Errorf("comment author = %q, want alice", result.Threads[0].Comments.Nodes[0].Author.Login)
+	}
+}
+
+func TestListReviewThreadsPagesComments(t *testing.T) {
+	var commentCursors []interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		w.Header().Set("Content-Type", "application/json")
+		if req.Variables["id"] == nil {
+			w.Write([]byte(`{"data":{"repository":{"pullRequest":{"reviewThreads":{
+				"totalCount": 1,
+				"pageInfo": {"hasNextPage": false, "endCursor": "t1"},
+				"nodes": [{"id": "PRRT_1", "path": "a.go", "line": 3, "comments": {
+					"totalCount": 3,
+					"pageInfo": {"hasNextPage": true, "endCursor": "c1"},
+					"nodes": [{"body": "First"}]
+				}}]}}}}}`))
+			return
+		}
+
+		commentCursors = append(commentCursors, req.Variables["cursor"])
+		if req.Variables["cursor"] == "c1" {
+			w.Write([]byte(`{"data":{"node":{"comments":{"pageInfo": {"hasNextPage": true, "endCursor": "c2"}, "nodes": [{"body": "Second"}]}}}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"node":{"comments":{"pageInfo": {"hasNextPage": false, "endCursor": "c3"}, "nodes": [{"body": "Third"}]}}}}`))
+	})
+	reviewOps := NewReviewOperations(client, logrus.New())
+
+	result, err := reviewOps.ListReviewThreads(context.Background(), "octo", "repo", 5, false)
+	if err != nil {
+		t.Fatalf("ListReviewThreads() error = %v", err)
+	}
+
+	if len(commentCursors) != 2 || commentCursors[0] != "c1" || commentCursors[1] != "c2" {
+		t.Errorf("comment cursors = %v, want [c1 c2]", commentCursors)
+	}
+	comments := result.Threads[0].Comments.Nodes
+	if len(comments) != 3 || comments[2].Body != "Third" {
+		t.Errorf("comments = %d, want all 3 comments in order", len(comments))
+	}
+}
+
+func TestSetReviewThreadResolved(t *testing.T) {
+	var req graphQLRequest
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"unresolveReviewThread":{"thread":{"id":"PRRT_1","isResolved":false}}}}`))
+	})
+	reviewOps := NewReviewOperations(client, logrus.New())
+
+	thread, err := reviewOps.SetReviewThreadResolved(context.Background(), "PRRT_1", false)
+	if err != nil {
+		t.Fatalf("SetReviewThreadResolved() error = %v", err)
+	}
+	if !strings.Contains(req.Query, "unresolveReviewThread") || req.Variables["threadId"] != "PRRT_1" {
+		t.Errorf("request = %+v, want unresolveReviewThread for PRRT_1", req)
+	}
+	if thread.IsResolved {
+		t.Error("IsResolved = true, want false")
+	}
+}
+
+func TestReplyToReviewThread(t *testing.T) {
+	var req graphQLRequest
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"addPullRequestReviewThreadReply":{"comment":{"databaseId": 42, "body": "Done", "author": {"login": "bot"}}}}}`))
+	})
+	reviewOps := NewReviewOperations(client, logrus.New())
+
+	comment, err := reviewOps.ReplyToReviewThread(context.Background(), "PRRT_1", "Done")
+	if err != nil {
+		t.Fatalf("ReplyToReviewThread() error = %v", err)
+	}
+	if req.Variables["threadId"] != "PRRT_1" || req.Variables["body"] != "Done" {
+		t.Errorf("variables = %v", req.Variables)
+	}
+	if comment.DatabaseID != 42 {
+		t.Errorf("DatabaseID = %d, want 42", comment.DatabaseID)
+	}
+}
+
+func TestSuggestReviewers(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/repos/octo/repo/pulls/5":
+			w.Write([]byte(`{"number": 5, "user": {"login": "Alice"}, "base": {"ref": "main"}}`))
+		case "/repos/octo/repo/contents/.github/CODEOWNERS":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "Not Found"}`))
+		case "/repos/octo/repo/contents/CODEOWNERS":
+			if r.URL.Query().Get("ref") != "main" {
+				t.Errorf("ref = %q, want main", r.URL.Query().Get("ref"))
+			}
+			// "*.go @alice @octo/go-team\n/docs/ @bob docs@example.com\n"
+			w.Write([]byte(`{"type": "file", "encoding": "base64", "content": "Ki5nbyBAYWxpY2UgQG9jdG8vZ28tdGVhbQovZG9jcy8gQGJvYiBkb2NzQGV4YW1wbGUuY29tCg=="}`))
+		case "/repos/octo/repo/pulls/5/files":
+			w.Write([]byte(`[{"filename": "main.go"}, {"filename": "docs/a.md"}, {"filename": "Makefile"}, {"filename": "pkg/b.go"}]`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	reviewOps := NewReviewOperations(client, logrus.New())
+
+	suggestions, err := reviewOps.SuggestReviewers(context.Background(), "octo", "repo", 5)
+	if err != nil {
+		t.Fatalf("SuggestReviewers() error = %v", err)
+	}
+
+	if suggestions.CodeOwnersPath != "CODEOWNERS" {
+		t.Errorf("CodeOwnersPath = %q, want CODEOWNERS", suggestions.CodeOwnersPath)
+	}
+	if len(suggestions.Suggestions) != 2 || suggestions.Suggestions[0].Owner != "@octo/go-team" || len(suggestions.Suggestions[0].Files) != 2 {
+		t.Errorf("Suggestions = %+v, want the Go team for two files and bob, without the author", suggestions.Suggestions)
+	}
+	if got := strings.Join(suggestions.Users(), ","); got != "bob" {
+		t.Errorf("Users() = %q, want bob", got)
+	}
+	if got := strings.Join(suggestions.TeamSlugs("Octo"), ","); got != "go-team" {
+		t.Errorf("TeamSlugs() = %q, want go-team", got)
+	}
+	if got := suggestions.TeamSlugs("other"); len(got) != 0 {
+		t.Errorf("TeamSlugs(other) = %v, want no teams of another organization", got)
+	}
+	if len(suggestions.UnownedFiles) != 1 || suggestions.UnownedFiles[0] != "Makefile" {
+		t.Errorf("UnownedFiles = %v, want [Makefile]", suggestions.UnownedFiles)
+	}
+}
+
+func TestRemoveReviewers(t *testing.T) {
+	var removed map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/octo/repo/pulls/5/requested_reviewers" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodDelete {
+			json.NewDecoder(r.Body).Decode(&removed)
+			w.Write([]byte(`{"number": 5}`))
+			return
+		}
+		w.Write([]byte(`{"users": [{"login": "carol"}], "teams": []}`))
+	})
+	reviewOps := NewReviewOperations(client, logrus.New())
+
+	remaining, err := reviewOps.RemoveReviewers(context.Background(), "octo", "repo", 5, []string{"bob"}, []string{"docs"})
+	if err != nil {
+		t.Fatalf("RemoveReviewers() error = %v", err)
+	}
+	if fmt.Sprint(removed["reviewers"]) != "[bob]" || fmt.Sprint(removed["team_reviewers"]) != "[docs]" {
+		t.Errorf("request = %v", removed)
+	}
+	if len(remaining.Users) != 1 || remaining.Users[0].GetLogin() != "carol" {
+		t.Errorf("remaining = %+v, want carol", remaining)
+	}
+
+	if _, err := reviewOps.RemoveReviewers(context.Background(), "octo", "repo", 5, nil, nil); err == nil {
+		t.Error("expected a validation error without reviewers")
+	}
+}
+
+func TestListRequestedReviewersFollowsPages(t *testing.T) {
+	var serverURL string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			w.Write([]byte(`{"users": [{"login": "dave"}], "teams": [{"slug": "docs"}]}`))
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s%s?page=2&per_page=100>; rel="next"`, serverURL, r.URL.Path))
+		w.Write([]byte(`{"users": [{"login": "carol"}], "teams": []}`))
+	})
+	serverURL = strings.TrimSuffix(client.GetClient().BaseURL.String(), "/")
+	reviewOps := NewReviewOperations(client, logrus.New())
+
+	reviewers, err := reviewOps.ListRequestedReviewers(context.Background(), "octo", "repo", 5)
+	if err != nil {
+		t.Fatalf("ListRequestedReviewers() error = %v", err)
+	}
+	if len(reviewers.Users) != 2 || reviewers.Users[1].GetLogin() != "dave" || len(reviewers.Teams) != 1 {
+		t.Errorf("reviewers = %+v, want the users and teams of both pages", reviewers)
+	}
+}
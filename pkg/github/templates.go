@@ -0,0 +1,564 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v69/github"
+	"gopkg.in/yaml.v3"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+)
+
+// IssueTemplateDir is the directory GitHub reads issue templates and forms from
+const IssueTemplateDir = ".github/ISSUE_TEMPLATE"
+
+// Issue template kinds
+const (
+	IssueTemplateMarkdown = "markdown"
+	IssueTemplateForm     = "form"
+)
+
+// Issue form field types
+const (
+	IssueFormMarkdown   = "markdown"
+	IssueFormInput      = "input"
+	IssueFormTextarea   = "textarea"
+	IssueFormDropdown   = "dropdown"
+	IssueFormCheckboxes = "checkboxes"
+)
+
+// noResponse is what GitHub renders for an empty issue form field
+const noResponse = "_No response_"
+
+// stringList is a YAML list of strings that may also be written as a comma-separated string
+type stringList []string
+
+// UnmarshalYAML implements yaml.Unmarshaler
+func (l *stringList) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*l = nil
+		for _, item := range strings.Split(value.Value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				*l = append(*l, item)
+			}
+		}
+		return nil
+	}
+	var items []string
+	if err := value.Decode(&items); err != nil {
+		return err
+	}
+	*l = items
+	return nil
+}
+
+// IssueFormOption is an option of a dropdown or checkboxes field
+type IssueFormOption struct {
+	Label    string
+	Required bool
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler; dropdown options are plain strings
+func (o *IssueFormOption) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		o.Label = value.Value
+		return nil
+	}
+	var option struct {
+		Label    string `yaml:"label"`
+		Required bool   `yaml:"required"`
+	}
+	if err := value.Decode(&option); err != nil {
+		return err
+	}
+	o.Label, o.Required = option.Label, option.Required
+	return nil
+}
+
+// IssueFormField is an element of an issue form's body
+type IssueFormField struct {
+	Type       string `yaml:"type"`
+	ID         string `yaml:"id"`
+	Attributes struct {
+		Label       string            `yaml:"label"`
+		Description string            `yaml:"description"`
+		Placeholder string            `yaml:"placeholder"`
+		Value       string            `yaml:"value"`
+		Render      string            `yaml:"render"`
+		Multiple    bool              `yaml:"multiple"`
+		Options     []IssueFormOption `yaml:"options"`
+		Default     *int              `yaml:"default"`
+	} `yaml:"attributes"`
+	Validations struct {
+		Required bool `yaml:"required"`
+	} `yaml:"validations"`
+}
+
+// Key is the name a value for this field is given under: its ID, or its label if it has none
+func (f *IssueFormField) Key() string {
+	if f.ID != "" {
+		return f.ID
+	}
+	return f.Attributes.Label
+}
+
+// IssueTemplate is a markdown issue template or a YAML issue form
+type IssueTemplate struct {
+	Path        string
+	Kind        string
+	Name        string
+	Description string
+	Title       string
+	Labels      []string
+	Assignees   []string
+	// Body is the text of a markdown template
+	Body string
+	// Fields are the elements of an issue form
+	Fields []IssueFormField
+	// Error is set if the template could not be parsed; GitHub does not offer such templates
+	Error string
+}
+
+// ParseIssueTemplate parses a markdown issue template or a YAML issue form, depending on the file extension
+func ParseIssueTemplate(filePath, content string) (*IssueTemplate, error) {
+	switch strings.ToLower(path.Ext(filePath)) {
+	case ".md":
+		return parseMarkdownTemplate(filePath, content)
+	case ".yml", ".yaml":
+		return parseIssueForm(filePath, content)
+	default:
+		return nil, errors.NewValidationError(fmt.Sprintf("%s is neither a markdown template nor a YAML issue form", filePath))
+	}
+}
+
+// parseMarkdownTemplate parses a markdown template with YAML front matter
+func parseMarkdownTemplate(filePath, content string) (*IssueTemplate, error) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	if !strings.HasPrefix(content, "---\n") {
+		return nil, errors.NewValidationError(fmt.Sprintf("%s has no front matter with a name and about", filePath))
+	}
+	end := strings.Index(content[4:], "\n---")
+	if end == -1 {
+		return nil, errors.NewValidationError(fmt.Sprintf("%s: front matter is not closed with ---", filePath))
+	}
+	frontMatter := content[4 : 4+end]
+	body := strings.TrimPrefix(content[4+end+len("\n---"):], "\n")
+
+	var header struct {
+		Name      string     `yaml:"name"`
+		About     string     `yaml:"about"`
+		Title     string     `yaml:"title"`
+		Labels    stringList `yaml:"labels"`
+		Assignees stringList `yaml:"assignees"`
+	}
+	if err := yaml.Unmarshal([]byte(frontMatter), &header); err != nil {
+		return nil, errors.NewValidationError(fmt.Sprintf("%s: invalid front matter: %v", filePath, err))
+	}
+	if header.Name == "" {
+		return nil, errors.NewValidationError(fmt.Sprintf("%s: front matter has no name", filePath))
+	}
+
+	return &IssueTemplate{
+		Path:        filePath,
+		Kind:        IssueTemplateMarkdown,
+		Name:        header.Name,
+		Description: header.About,
+		Title:       header.Title,
+		Labels:      header.Labels,
+		Assignees:   header.Assignees,
+		Body:        body,
+	}, nil
+}
+
+// parseIssueForm parses and checks a YAML issue form
+func parseIssueForm(filePath, content string) (*IssueTemplate, error) {
+	var form struct {
+		Name        string           `yaml:"name"`
+		Description string           `yaml:"description"`
+		Title       string           `yaml:"title"`
+		Labels      stringList       `yaml:"labels"`
+		Assignees   stringList       `yaml:"assignees"`
+		Body        []IssueFormField `yaml:"body"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &form); err != nil {
+		return nil, errors.NewValidationError(fmt.Sprintf("%s: invalid issue form: %v", filePath, err))
+	}
+	if form.Name == "" {
+		return nil, errors.NewValidationError(fmt.Sprintf("%s: issue form has no name", filePath))
+	}
+	if len(form.Body) == 0 {
+		return nil, errors.NewValidationError(fmt.Sprintf("%s: issue form has no body", filePath))
+	}
+
+	keys := make(map[string]bool)
+	for i, field := range form.Body {
+		switch field.Type {
+		case IssueFormMarkdown:
+			continue
+		case IssueFormInput, IssueFormTextarea:
+		case IssueFormDropdown, IssueFormCheckboxes:
+			if len(field.Attributes.Options) == 0 {
+				return nil, errors.NewValidationError(fmt.Sprintf("%s: %s field %q has no options", filePath, field.Type, field.Key()))
+			}
+			if field.Attributes.Default != nil && (*field.Attributes.Default < 0 || *field.Attributes.Default >= len(field.Attributes.Options)) {
+				return nil, errors.NewValidationError(fmt.Sprintf("%s: default of dropdown %q is not an option index", filePath, field.Key()))
+			}
+		default:
+			return nil, errors.NewValidationError(fmt.Sprintf("%s: body element %d has unknown type %q", filePath, i+1, field.Type))
+		}
+		if field.Attributes.Label == "" {
+			return nil, errors.NewValidationError(fmt.Sprintf("%s: %s field %d has no label", filePath, field.Type, i+1))
+		}
+		if keys[field.Key()] {
+			return nil, errors.NewValidationError(fmt.Sprintf("%s: field %q is defined more than once", filePath, field.Key()))
+		}
+		keys[field.Key()] = true
+	}
+
+	return &IssueTemplate{
+		Path:        filePath,
+		Kind:        IssueTemplateForm,
+		Name:        form.Name,
+		Description: form.Description,
+		Title:       form.Title,
+		Labels:      form.Labels,
+		Assignees:   form.Assignees,
+		Fields:      form.Body,
+	}, nil
+}
+
+// fieldValues converts a field value given as a string, number, boolean or list to strings
+func fieldValues(value interface{}) ([]string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return nil, true
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return nil, true
+		}
+		return []string{v}, true
+	case []string:
+		return v, true
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, s)
+		}
+		return values, true
+	case float64, int, bool:
+		return []string{fmt.Sprint(v)}, true
+	default:
+		return nil, false
+	}
+}
+
+// RenderIssueForm checks the values against an issue form and renders the issue body the way
+// GitHub does: a "### Label" section per field, with "_No response_" for empty fields.
+// Values are keyed by field ID (or label for fields without one). Dropdowns take an option or a
+// list of options; checkboxes take the list of checked option labels.
+func RenderIssueForm(template *IssueTemplate, values map[string]interface{}) (string, error) {
+	if template.Kind != IssueTemplateForm {
+		return "", errors.NewValidationError(fmt.Sprintf("%s is not an issue form", template.Path))
+	}
+
+	// Reject values for fields the form does not have
+	var keys []string
+	known := make(map[string]bool)
+	for _, field := range template.Fields {
+		if field.Type != IssueFormMarkdown {
+			keys = append(keys, field.Key())
+			known[field.Key()] = true
+		}
+	}
+	var unknown []string
+	for key := range values {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return "", errors.NewValidationError(fmt.Sprintf("unknown fields %s; the form has: %s", strings.Join(unknown, ", "), strings.Join(keys, ", ")))
+	}
+
+	var sections []string
+	var problems []string
+	for _, field := range template.Fields {
+		if field.Type == IssueFormMarkdown {
+			continue
+		}
+		key := field.Key()
+		raw, given := values[key]
+		selected, ok := fieldValues(raw)
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s: value must be a string or a list of strings", key))
+			continue
+		}
+
+		var content string
+		switch field.Type {
+		case IssueFormInput, IssueFormTextarea:
+			if !given {
+				selected, _ = fieldValues(field.Attributes.Value)
+			}
+			if len(selected) > 1 {
+				problems = append(problems, fmt.Sprintf("%s: expects a single value", key))
+				continue
+			}
+			if len(selected) == 0 {
+				if field.Validations.Required {
+					problems = append(problems, fmt.Sprintf("%s is required", key))
+				}
+				content = noResponse
+			} else if field.Type == IssueFormTextarea && field.Attributes.Render != "" {
+				content = fmt.Sprintf("```%s\n%s\n```", field.Attributes.Render, selected[0])
+			} else {
+				content = selected[0]
+			}
+
+		case IssueFormDropdown:
+			if !given && field.Attributes.Default != nil {
+				selected = []string{field.Attributes.Options[*field.Attributes.Default].Label}
+			}
+			if len(selected) > 1 && !field.Attributes.Multiple {
+				problems = append(problems, fmt.Sprintf("%s: only one option can be selected", key))
+				continue
+			}
+			invalid := false
+			for _, value := range selected {
+				if !field.hasOption(value) {
+					problems = append(problems, fmt.Sprintf("%s: %q is not an option (options: %s)", key, value, field.optionLabels()))
+					invalid = true
+				}
+			}
+			if invalid {
+				continue
+			}
+			if len(selected) == 0 {
+				if field.Validations.Required {
+					problems = append(problems, fmt.Sprintf("%s is required (options: %s)", key, field.optionLabels()))
+				}
+				content = noResponse
+			} else {
+				content = strings.Join(selected, ", ")
+			}
+
+		case IssueFormCheckboxes:
+			checked := make(map[string]bool)
+			for _, value := range selected {
+				if !field.hasOption(value) {
+					problems = append(problems, fmt.Sprintf("%s: %q is not an option (options: %s)", key, value, field.optionLabels()))
+				}
+				checked[value] = true
+			}
+			var lines []string
+			for _, option := range field.Attributes.Options {
+				mark := " "
+				if checked[option.Label] {
+					mark = "X"
+				} else if option.Required {
+					problems = append(problems, fmt.Sprintf("%s: %q must be checked", key, option.Label))
+				}
+				lines = append(lines, fmt.Sprintf("- [%s] %s", mark, option.Label))
+			}
+			content = strings.Join(lines, "\n")
+		}
+
+		sections = append(sections, fmt.Sprintf("### %s\n\n%s", field.Attributes.Label, content))
+	}
+	if len(problems) > 0 {
+		return "", errors.NewValidationError("invalid field values: " + strings.Join(problems, "; "))
+	}
+
+	return strings.Join(sections, "\n\n"), nil
+}
+
+// hasOption reports whether the field has an option with the given label
+func (f *IssueFormField) hasOption(label string) bool {
+	for _, option := range f.Attributes.Options {
+		if option.Label == label {
+			return true
+		}
+	}
+	return false
+}
+
+// optionLabels lists the option labels of a field
+func (f *IssueFormField) optionLabels() string {
+	labels := make([]string, len(f.Attributes.Options))
+	for i, option := range f.Attributes.Options {
+		labels[i] = option.Label
+	}
+	return strings.Join(labels, ", ")
+}
+
+// ListIssueTemplates lists the markdown templates and issue forms of a repository.
+// Templates that cannot be parsed are listed with an error instead of failing the whole list.
+func (i *IssueOperations) ListIssueTemplates(ctx context.Context, owner, repo, ref string) ([]*IssueTemplate, error) {
+	fileOps := NewFileOperations(i.client, i.logger)
+	contents, err := fileOps.GetFileContents(ctx, owner, repo, IssueTemplateDir, ref)
+	if err != nil {
+		if errors.IsType(err, errors.ErrorTypeNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	entries, ok := contents.([]*github.RepositoryContent)
+	if !ok {
+		return nil, errors.NewValidationError(fmt.Sprintf("%s is a file, not a directory", IssueTemplateDir))
+	}
+
+	var templates []*IssueTemplate
+	for _, entry := range entries {
+		name := strings.ToLower(entry.GetName())
+		if entry.GetType() != "file" || name == "config.yml" || name == "config.yaml" {
+			continue
+		}
+		switch path.Ext(name) {
+		case ".md", ".yml", ".yaml":
+		default:
+			continue
+		}
+
+		template, err := i.getIssueTemplate(ctx, fileOps, owner, repo, entry.GetPath(), ref)
+		if err != nil {
+			templates = append(templates, &IssueTemplate{Path: entry.GetPath(), Name: entry.GetName(), Error: err.Error()})
+			continue
+		}
+		templates = append(templates, template)
+	}
+
+	return templates, nil
+}
+
+// getIssueTemplate reads and parses a single template
+func (i *IssueOperations) getIssueTemplate(ctx context.Context, fileOps *FileOperations, owner, repo, filePath, ref string) (*IssueTemplate, error) {
+	contents, err := fileOps.GetFileContents(ctx, owner, repo, filePath, ref)
+	if err != nil {
+		return nil, err
+	}
+	file, ok := contents.(*github.RepositoryContent)
+	if !ok {
+		return nil, errors.NewValidationError(fmt.Sprintf("%s is a directory, not a template", filePath))
+	}
+	content, err := fileOps.DecodeFileContent(file)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssueTemplate(filePath, content)
+}
+
+// IssueFromTemplate holds what to fill into an issue template
+type IssueFromTemplate struct {
+	// Template is the file name (e.g. "bug_report.yml") or the name of the template
+	Template string
+	// Title is prefixed with the template's title unless it already starts with it
+	Title string
+	// Body replaces the text of a markdown template; issue forms use Fields instead
+	Body string
+	// Fields are the values of an issue form, keyed by field ID
+	Fields map[string]interface{}
+	// Labels and Assignees are added to the template's defaults
+	Labels    []string
+	Assignees []string
+}
+
+// CreateIssueFromTemplate creates an issue from a markdown template or an issue form,
+// applying the template's title prefix, default labels and assignees
+func (i *IssueOperations) CreateIssueFromTemplate(ctx context.Context, owner, repo string, input IssueFromTemplate) (*github.Issue, error) {
+	// Validate parameters
+	if input.Template == "" {
+		return nil, errors.NewValidationError("template cannot be empty")
+	}
+
+	templates, err := i.ListIssueTemplates(ctx, owner, repo, "")
+	if err != nil {
+		return nil, err
+	}
+	template, err := findIssueTemplate(templates, input.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	title, body, err := fillIssueTemplate(template, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.CreateIssue(ctx, owner, repo, title, body, mergeStrings(template.Labels, input.Labels), mergeStrings(template.Assignees, input.Assignees), 0)
+}
+
+// findIssueTemplate finds a template by file name or name
+func findIssueTemplate(templates []*IssueTemplate, name string) (*IssueTemplate, error) {
+	var available []string
+	for _, template := range templates {
+		if strings.EqualFold(path.Base(template.Path), name) || strings.EqualFold(template.Name, name) {
+			if template.Error != "" {
+				return nil, errors.NewValidationError(fmt.Sprintf("template %s is invalid: %s", template.Path, template.Error))
+			}
+			return template, nil
+		}
+		available = append(available, path.Base(template.Path))
+	}
+	if len(available) == 0 {
+		return nil, errors.NewNotFoundError(fmt.Sprintf("the repository has no issue templates in %s", IssueTemplateDir))
+	}
+	return nil, errors.NewNotFoundError(fmt.Sprintf("issue template %q not found (available: %s)", name, strings.Join(available, ", ")))
+}
+
+// fillIssueTemplate computes the title and body of an issue created from a template
+func fillIssueTemplate(template *IssueTemplate, input IssueFromTemplate) (string, string, error) {
+	title := strings.TrimSpace(input.Title)
+	if template.Title != "" && !strings.HasPrefix(title, template.Title) {
+		title = template.Title + title
+	}
+	if strings.TrimSpace(title) == "" || title == template.Title {
+		return "", "", errors.NewValidationError("title cannot be empty")
+	}
+
+	if template.Kind == IssueTemplateMarkdown {
+		if len(input.Fields) > 0 {
+			return "", "", errors.NewValidationError(fmt.Sprintf("%s is a markdown template; pass body instead of fields", template.Path))
+		}
+		body := input.Body
+		if body == "" {
+			body = template.Body
+		}
+		return title, body, nil
+	}
+
+	if input.Body != "" {
+		return "", "", errors.NewValidationError(fmt.Sprintf("%s is an issue form; pass fields instead of body", template.Path))
+	}
+	body, err := RenderIssueForm(template, input.Fields)
+	if err != nil {
+		return "", "", err
+	}
+	return title, body, nil
+}
+
+// mergeStrings appends the extra values that are not in base yet, case-insensitively
+func mergeStrings(base, extra []string) []string {
+	merged := append([]string(nil), base...)
+	for _, value := range extra {
+		found := false
+		for _, existing := range merged {
+			if strings.EqualFold(existing, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, value)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,227 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+const bugReportForm = `name: Bug report
+description: File a bug report
+title: "[Bug]: "
+labels: ["bug", "triage"]
+assignees: octocat
+body:
+  - type: markdown
+    attributes:
+      value: Thanks for taking the time to fill out this bug report!
+  - type: textarea
+    id: what-happened
+    attributes:
+      label: What happened?
+    validations:
+      required: true
+  - type: dropdown
+    id: version
+    attributes:
+      label: Version
+      options:
+        - 1.0.2 (Default)
+        - 1.0.3 (Edge)
+      default: 0
+  - type: dropdown
+    id: browsers
+    attributes:
+      label: Browsers
+      multiple: true
+      options: [Firefox, Chrome, Safari]
+  - type: textarea
+    id: logs
+    attributes:
+      label: Relevant log output
+      render: shell
+  - type: input
+    id: contact
+    attributes:
+      label: Contact
+  - type: checkboxes
+    id: terms
+    attributes:
+      label: Code of Conduct
+      options:
+        - label: I agree to follow this project's Code of Conduct
+          required: true
+        - label: I searched for duplicates
+`
+
+func TestParseIssueTemplate(t *testing.T) {
+	markdown, err := ParseIssueTemplate(".github/ISSUE_TEMPLATE/feature.md", "---\r\nname: Feature request\r\nabout: Suggest an idea\r\ntitle: ''\r\nlabels: enhancement, needs-triage\r\nassignees: ''\r\n---\r\n\r\n**Describe the feature**\r\n")
+	if err != nil {
+		t.Fatalf("ParseIssueTemplate(markdown) error = %v", err)
+	}
+	if markdown.Kind != IssueTemplateMarkdown || markdown.Name != "Feature request" || strings.Join(markdown.Labels, ",") != "enhancement,needs-triage" || len(markdown.Assignees) != 0 {
+		t.Errorf("markdown template = %+v", markdown)
+	}
+	if markdown.Body != "\n**Describe the feature**\n" {
+		t.Errorf("Body = %q", markdown.Body)
+	}
+
+	form, err := ParseIssueTemplate(".github/ISSUE_TEMPLATE/bug.yml", bugReportForm)
+	if err != nil {
+		t.Fatalf("ParseIssueTemplate(form) error = %v", err)
+	}
+	if form.Kind != IssueTemplateForm || len(form.Fields) != 7 || strings.Join(form.Assignees, ",") != "octocat" {
+		t.Errorf("form = %+v", form)
+	}
+	if terms := form.Fields[6]; !terms.Attributes.Options[0].Required || terms.Attributes.Options[1].Label != "I searched for duplicates" {
+		t.Errorf("checkbox options = %+v", terms.Attributes.Options)
+	}
+
+	invalid := []struct {
+		path, content, wantErr string
+	}{
+		{"a.md", "Just text", "no front matter"},
+		{"a.md", "---\nabout: x\n---\n", "has no name"},
+		{"a.yml", "name: x\n", "has no body"},
+		{"a.yml", "name: x\nbody:\n  - type: dropdown\n    id: d\n    attributes:\n      label: D\n", "has no options"},
+		{"a.yml", "name: x\nbody:\n  - type: slider\n", "unknown type"},
+		{"a.yml", "name: x\nbody:\n  - type: input\n    id: a\n    attributes:\n      label: A\n  - type: input\n    id: a\n    attributes:\n      label: B\n", "more than once"},
+		{"a.txt", "", "neither"},
+	}
+	for _, tc := range invalid {
+		if _, err := ParseIssueTemplate(tc.path, tc.content); err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+			t.Errorf("ParseIssueTemplate(%q) error = %v, want one containing %q", tc.content, err, tc.wantErr)
+		}
+	}
+}
+
+func TestRenderIssueForm(t *testing.T) {
+	form, err := ParseIssueTemplate("bug.yml", bugReportForm)
+	if err != nil {
+		t.Fatalf("ParseIssueTemplate() error = %v", err)
+	}
+
+	body, err := RenderIssueForm(form, map[string]interface{}{
+		"what-happened": "It crashed",
+		"browsers":      []interface{}{"Firefox", "Safari"},
+		"logs":          "panic: boom",
+		"terms":         []interface{}{"I agree to follow this project's Code of Conduct"},
+	})
+	if err != nil {
+		t.Fatalf("RenderIssueForm() error = %v", err)
+	}
+	want := "### What happened?\n\nIt crashed\n\n" +
+		"### Version\n\n1.0.2 (Default)\n\n" +
+		"### Browsers\n\nFirefox, Safari\n\n" +
+		"### Relevant log output\n\n```shell\npanic: boom\n```\n\n" +
+		"### Contact\n\n_No response_\n\n" +
+		"### Code of Conduct\n\n- [X] I agree to follow this project's Code of Conduct\n- [ ] I searched for duplicates"
+	if body != want {
+		t.Errorf("body =\n%s\nwant\n%s", body, want)
+	}
+
+	testCases := []struct {
+		name    string
+		values  map[string]interface{}
+		wantErr []string
+	}{
+		{
+			name:    "MissingRequired",
+			values:  map[string]interface{}{"terms": []interface{}{"I agree to follow this project's Code of Conduct"}},
+			wantErr: []string{"what-happened is required"},
+		},
+		{
+			name:    "InvalidOption",
+			values:  map[string]interface{}{"what-happened": "x", "version": "2.0", "terms": "I agree to follow this project's Code of Conduct"},
+			wantErr: []string{`"2.0" is not an option`},
+		},
+		{
+			name:    "MultipleForSingleDropdown",
+			values:  map[string]interface{}{"what-happened": "x", "version": []interface{}{"1.0.2 (Default)", "1.0.3 (Edge)"}},
+			wantErr: []string{"only one option", "must be checked"},
+		},
+		{
+			name:    "UnknownField",
+			values:  map[string]interface{}{"os": "Linux"},
+			wantErr: []string{"unknown fields os", "the form has: what-happened, version"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := RenderIssueForm(form, tc.values)
+			for _, want := range tc.wantErr {
+				if err == nil || !strings.Contains(err.Error(), want) {
+					t.Errorf("error = %v, want one containing %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateIssueFromTemplate(t *testing.T) {
+	var created map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/repos/octo/repo/contents/.github/ISSUE_TEMPLATE":
+			w.Write([]byte(`[
+				{"type": "file", "name": "bug.yml", "path": ".github/ISSUE_TEMPLATE/bug.yml"},
+				{"type": "file", "name": "config.yml", "path": ".github/ISSUE_TEMPLATE/config.yml"},
+				{"type": "file", "name": "broken.md", "path": ".github/ISSUE_TEMPLATE/broken.md"}
+			]`))
+		case r.URL.Path == "/repos/octo/repo/contents/.github/ISSUE_TEMPLATE/bug.yml":
+			content := base64.StdEncoding.EncodeToString([]byte(bugReportForm))
+			w.Write([]byte(`{"type": "file", "encoding": "base64", "content": "` + content + `"}`))
+		case r.URL.Path == "/repos/octo/repo/contents/.github/ISSUE_TEMPLATE/broken.md":
+			content := base64.StdEncoding.EncodeToString([]byte("no front matter"))
+			w.Write([]byte(`{"type": "file", "encoding": "base64", "content": "` + content + `"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/octo/repo/issues":
+			json.NewDecoder(r.Body).Decode(&created)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"number": 12}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	issueOps := NewIssueOperations(client, logrus.New())
+
+	templates, err := issueOps.ListIssueTemplates(context.Background(), "octo", "repo", "")
+	if err != nil {
+		t.Fatalf("ListIssueTemplates() error = %v", err)
+	}
+	if len(templates) != 2 || templates[0].Name != "Bug report" || templates[1].Error == "" {
+		t.Fatalf("templates = %+v, want the form and the broken template with an error", templates)
+	}
+
+	_, err = issueOps.CreateIssueFromTemplate(context.Background(), "octo", "repo", IssueFromTemplate{
+		Template:  "Bug Report",
+		Title:     "Crash on start",
+		Fields:    map[string]interface{}{"what-happened": "It crashed", "terms": []interface{}{"I agree to follow this project's Code of Conduct"}},
+		Labels:    []string{"Triage", "p1"},
+		Assignees: []string{"alice"},
+	})
+	if err != nil {
+		t.Fatalf("CreateIssueFromTemplate() error = %v", err)
+	}
+	if created["title"] != "[Bug]: Crash on start" {
+		t.Errorf("title = %v, want the template's prefix", created["title"])
+	}
+	labels, _ := json.Marshal(created["labels"])
+	assignees, _ := json.Marshal(created["assignees"])
+	if string(labels) != `["bug","triage","p1"]` || string(assignees) != `["octocat","alice"]` {
+		t.Errorf("labels = %s, assignees = %s, want the template's defaults plus the extra ones", labels, assignees)
+	}
+	if body, _ := created["body"].(string); !strings.HasPrefix(body, "### What happened?\n\nIt crashed\n\n### Version\n\n1.0.2 (Default)") {
+		t.Errorf("body = %q", body)
+	}
+
+	_, err = issueOps.CreateIssueFromTemplate(context.Background(), "octo", "repo", IssueFromTemplate{Template: "broken.md", Title: "x"})
+	if err == nil || !strings.Contains(err.Error(), "is invalid") {
+		t.Errorf("error = %v, want the broken template to be rejected", err)
+	}
+}
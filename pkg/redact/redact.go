@@ -0,0 +1,152 @@
+// Package redact masks secrets such as GitHub tokens and signed URL query strings
+// before they reach logs, error messages or tool results.
+package redact
+
+import (
+	"bufio"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Mask is the replacement for redacted secrets
+const Mask = "[REDACTED]"
+
+var (
+	// tokenPattern matches classic GitHub tokens (ghp_, gho_, ghu_, ghs_, ghr_) and fine-grained personal access tokens
+	tokenPattern = regexp.MustCompile(`\b(gh[pousr]_)[A-Za-z0-9]{20,255}\b|\b(github_pat_)[A-Za-z0-9_]{22,255}\b`)
+
+	// authHeaderPattern matches credentials in Authorization header values
+	authHeaderPattern = regexp.MustCompile(`(?i)\b(authorization:\s*(?:bearer|token|basic)\s+)[^\s"']+`)
+
+	// urlPattern matches URLs that carry a query string
+	urlPattern = regexp.MustCompile(`https?://[^\s"'<>?]+\?[^\s"'<>]+`)
+
+	// sensitiveQueryParams are query parameters that make a URL a bearer credential (pre-signed URLs)
+	sensitiveQueryParams = map[string]bool{
+		"sig":                  true,
+		"signature":            true,
+		"token":                true,
+		"access_token":         true,
+		"jwt":                  true,
+		"x-amz-signature":      true,
+		"x-amz-credential":     true,
+		"x-amz-security-token": true,
+		"x-goog-signature":     true,
+		"x-goog-credential":    true,
+	}
+)
+
+var (
+	secretsMu sync.RWMutex
+	secrets   []string
+)
+
+// minSecretLength guards against masking short, common strings
+const minSecretLength = 8
+
+// AddSecret registers a literal secret (e.g. the configured token) that is masked
+// regardless of its format
+func AddSecret(secret string) {
+	if len(secret) < minSecretLength {
+		return
+	}
+
+	secretsMu.Lock()
+	defer secretsMu.Unlock()
+	for _, existing := range secrets {
+		if existing == secret {
+			return
+		}
+	}
+	secrets = append(secrets, secret)
+}
+
+// String masks all secrets in s
+func String(s string) string {
+	if s == "" {
+		return s
+	}
+
+	secretsMu.RLock()
+	for _, secret := range secrets {
+		s = strings.ReplaceAll(s, secret, Mask)
+	}
+	secretsMu.RUnlock()
+
+	s = tokenPattern.ReplaceAllString(s, "${1}${2}"+Mask)
+	s = authHeaderPattern.ReplaceAllString(s, "${1}"+Mask)
+	s = urlPattern.ReplaceAllStringFunc(s, redactURL)
+	return s
+}
+
+// URL masks the query string of a URL if it contains signing parameters
+func URL(u string) string {
+	return redactURL(u)
+}
+
+func redactURL(u string) string {
+	base, query, found := strings.Cut(u, "?")
+	if !found {
+		return u
+	}
+
+	// JSON encoders escape "&" as \u0026
+	values, err := url.ParseQuery(strings.ReplaceAll(query, `\u0026`, "&"))
+	if err != nil {
+		// Unparseable query strings are masked to be on the safe side
+		return base + "?" + Mask
+	}
+	for key := range values {
+		if sensitiveQueryParams[strings.ToLower(key)] {
+			return base + "?" + Mask
+		}
+	}
+	return u
+}
+
+// Copy copies src to dst line by line, masking secrets in each line
+func Copy(dst io.Writer, src io.Reader) (int64, error) {
+	reader := bufio.NewReader(src)
+	var written int64
+	for {
+		line, readErr := reader.ReadString('\n')
+		if line != "" {
+			n, err := io.WriteString(dst, String(line))
+			written += int64(n)
+			if err != nil {
+				return written, err
+			}
+		}
+		if readErr == io.EOF {
+			return written, nil
+		}
+		if readErr != nil {
+			return written, readErr
+		}
+	}
+}
+
+// Formatter wraps a logrus formatter and masks secrets in the formatted entry,
+// including the message and all fields (e.g. errors attached via WithError)
+type Formatter struct {
+	Formatter logrus.Formatter
+}
+
+// NewFormatter creates a Formatter that redacts the output of formatter
+func NewFormatter(formatter logrus.Formatter) *Formatter {
+	return &Formatter{Formatter: formatter}
+}
+
+// Format implements logrus.Formatter
+func (f *Formatter) Format(entry *logrus.Entry) ([]byte, error) {
+	formatted, err := f.Formatter.Format(entry)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(String(string(formatted))), nil
+}
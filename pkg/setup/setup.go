@@ -5,6 +5,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/geropl/github-mcp-go/pkg/redact"
 )
 
 // SetupOptions contains the options for setting up the GitHub MCP server
@@ -37,7 +39,7 @@ func SetupMultiple(options SetupOptions, readOnlyTools map[string]bool) []error
 		err := setupSingleTool(toolOptions, readOnlyTools)
 		if err != nil {
 			errors = append(errors, fmt.Errorf("error setting up %s: %w", tool, err))
-			fmt.Printf("Error setting up %s: %v\n", tool, err)
+			fmt.Printf("Error setting up %s: %s\n", tool, redact.String(err.Error()))
 		} else {
 			fmt.Printf("github-mcp-go successfully set up for %s\n", tool)
 		}
@@ -213,9 +213,14 @@ func writeSettingsFile(settingsPath string, settings map[string]interface{}) err
 		return fmt.Errorf("failed to marshal settings: %w", err)
 	}
 
-	if err := os.WriteFile(settingsPath, data, 0644); err != nil {
+	// The settings contain the GitHub token, so they must only be readable by the owner
+	if err := os.WriteFile(settingsPath, data, 0600); err != nil {
 		return fmt.Errorf("failed to write settings: %w", err)
 	}
+	// WriteFile keeps the mode of existing files
+	if err := os.Chmod(settingsPath, 0600); err != nil {
+		return fmt.Errorf("failed to restrict settings file permissions: %w", err)
+	}
 
 	return nil
 }
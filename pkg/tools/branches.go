@@ -30,6 +30,15 @@ func RegisterBranchTools(s *Server) {
 		mcp.WithBoolean("protected",
 			mcp.Description("Filter to only protected branches"),
 		),
+		mcp.WithNumber("page",
+			mcp.Description("Fetch only this page (default: fetch pages automatically up to max_items)"),
+		),
+		mcp.WithNumber("per_page",
+			mcp.Description("Number of results per page (max 100, default 100)"),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Maximum number of results to fetch across pages when page is not set (default: 100, max: 1000)"),
+		),
 	)
 
 	s.RegisterTool(listBranchesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -49,8 +58,14 @@ func RegisterBranchTools(s *Server) {
 			protected = protectedVal
 		}
 
+		// Parse pagination
+		pagination, paginationErr := parsePaginationOptions(request.Params.Arguments)
+		if paginationErr != nil {
+			return mcp.NewToolResultError(errors.FormatGitHubError(paginationErr)), nil
+		}
+
 		// Call the operation
-		branches, err := branchOps.ListBranches(ctx, owner, repo, protected)
+		branches, err := branchOps.ListBranches(ctx, owner, repo, protected, pagination)
 		if err != nil {
 			if ghErr, ok := err.(*errors.GitHubError); ok {
 				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
@@ -59,7 +74,8 @@ func RegisterBranchTools(s *Server) {
 		}
 
 		// Format the result as markdown
-		markdown := formatBranchListToMarkdown(branches)
+		markdown := formatBranchListToMarkdown(branches.Items)
+		markdown += formatTruncationNote(branches)
 		return mcp.NewToolResultText(markdown), nil
 	})
 
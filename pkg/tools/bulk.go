@@ -0,0 +1,151 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+	"github.com/geropl/github-mcp-go/pkg/github"
+)
+
+// RegisterBulkTools registers tools that change many issues at once
+func RegisterBulkTools(s *Server) {
+	client := s.GetClient()
+	logger := s.GetLogger()
+	issueOps := github.NewIssueOperations(client, logger)
+
+	// Register bulk_update_issues tool
+	bulkUpdateIssuesTool := mcp.NewTool("bulk_update_issues",
+		mcp.WithDescription("Apply one action to every issue or pull request matching a search query. "+
+			"Call it without confirm first: it only previews the matched issues and returns a confirm token. "+
+			"Call it again with the same query, action and parameters plus that token to apply the action; "+
+			"the result reports the outcome per issue"),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description("Issue search query, as for search_issues (e.g. 'repo:owner/name is:open label:stale')"),
+		),
+		mcp.WithString("action",
+			mcp.Required(),
+			mcp.Description("Action to apply (add_labels, remove_labels, close, set_milestone, assign, comment, lock)"),
+		),
+		mcp.WithString("labels",
+			mcp.Description("Comma-separated labels for add_labels and remove_labels"),
+		),
+		mcp.WithString("state_reason",
+			mcp.Description("Reason for close (completed, not_planned) - default: completed"),
+		),
+		mcp.WithString("milestone",
+			mcp.Description("Milestone title or number for set_milestone, or 'none' to clear it"),
+		),
+		mcp.WithString("assignees",
+			mcp.Description("Comma-separated usernames for assign"),
+		),
+		mcp.WithString("body",
+			mcp.Description("Comment text for comment"),
+		),
+		mcp.WithString("lock_reason",
+			mcp.Description(fmt.Sprintf("Optional reason for lock (%s)", strings.Join(github.LockReasons, ", "))),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description(fmt.Sprintf("Refuse to run if the query matches more issues than this (default: %d, max: %d)", github.BulkDefaultMaxItems, github.BulkMaxItems)),
+		),
+		mcp.WithNumber("concurrency",
+			mcp.Description(fmt.Sprintf("Number of issues updated in parallel (default: %d, max: %d)", github.BulkDefaultConcurrency, github.BulkMaxConcurrency)),
+		),
+		mcp.WithString("confirm",
+			mcp.Description("Confirm token from the preview; without it, nothing is changed"),
+		),
+	)
+
+	s.RegisterTool(bulkUpdateIssuesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		query, ok := request.Params.Arguments["query"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("query must be a string"))), nil
+		}
+
+		actionType, ok := request.Params.Arguments["action"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("action must be a string"))), nil
+		}
+
+		action := github.BulkIssueAction{Type: actionType}
+		if labels, ok := request.Params.Arguments["labels"].(string); ok {
+			action.Labels = splitCommaList(labels)
+		}
+		action.StateReason, _ = request.Params.Arguments["state_reason"].(string)
+		action.Milestone, _ = request.Params.Arguments["milestone"].(string)
+		if assignees, ok := request.Params.Arguments["assignees"].(string); ok {
+			action.Assignees = splitCommaList(assignees)
+		}
+		action.Body, _ = request.Params.Arguments["body"].(string)
+		action.LockReason, _ = request.Params.Arguments["lock_reason"].(string)
+
+		var opts github.BulkOptions
+		if maxItems, ok := request.Params.Arguments["max_items"].(float64); ok {
+			opts.MaxItems = int(maxItems)
+		}
+		if concurrency, ok := request.Params.Arguments["concurrency"].(float64); ok {
+			opts.Concurrency = int(concurrency)
+		}
+		opts.ConfirmToken, _ = request.Params.Arguments["confirm"].(string)
+
+		// Call the operation
+		result, err := issueOps.BulkUpdateIssues(ctx, query, action, opts)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error updating issues: %v", err)), nil
+		}
+
+		// Format the result
+		return mcp.NewToolResultText(formatBulkIssueResultToMarkdown(result)), nil
+	})
+}
+
+// formatBulkIssueResultToMarkdown converts the preview or report of a bulk operation to markdown
+func formatBulkIssueResultToMarkdown(result *github.BulkIssueResult) string {
+	md := "# Bulk Update Preview\n\n"
+	if !result.Preview {
+		md = "# Bulk Update Report\n\n"
+	}
+	md += fmt.Sprintf("**Query:** `%s`  \n", result.Query)
+	md += fmt.Sprintf("**Action:** %s  \n", result.Action)
+
+	if len(result.Items) == 0 {
+		md += "\nNo issues match the query.\n"
+		return md
+	}
+
+	if result.Preview {
+		md += fmt.Sprintf("**Matched:** %d issues  \n", len(result.Items))
+		md += fmt.Sprintf("**Confirm token:** `%s`  \n\n", result.Token)
+		md += "Nothing has been changed. To apply the action, call bulk_update_issues again with the same parameters and this confirm token.\n\n"
+	} else {
+		counts := make(map[string]int)
+		for _, item := range result.Items {
+			counts[item.Status]++
+		}
+		md += fmt.Sprintf("**Result:** %d done, %d skipped, %d failed  \n\n",
+			counts[github.BulkStatusDone], counts[github.BulkStatusSkipped], counts[github.BulkStatusFailed])
+	}
+
+	md += "| Issue | Title | Status | Details |\n"
+	md += "|-------|-------|--------|---------|\n"
+	for _, item := range result.Items {
+		md += fmt.Sprintf("| %s#%d | %s | %s | %s |\n",
+			item.Repository,
+			item.Number,
+			escapeTableCell(truncateString(item.Title, 60)),
+			item.Status,
+			escapeTableCell(item.Message),
+		)
+	}
+	md += "\n"
+
+	return md
+}
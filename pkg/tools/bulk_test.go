@@ -0,0 +1,64 @@
+package tools
+
+import (
+	"testing"
+)
+
+func TestBulk(t *testing.T) {
+	testCases := []*TestCase{
+		// bulk_update_issues - Happy Path
+		{
+			Name: "BulkAddLabelsPreview",
+			Tool: "bulk_update_issues",
+			Input: map[string]interface{}{
+				"query":  "repo:" + OWNER + "/" + REPO + " is:open label:bug",
+				"action": "add_labels",
+				"labels": "triage",
+			},
+		},
+		{
+			Name: "BulkAddLabelsConfirm",
+			Tool: "bulk_update_issues",
+			Input: map[string]interface{}{
+				"query":       "repo:" + OWNER + "/" + REPO + " is:open label:bug",
+				"action":      "add_labels",
+				"labels":      "triage",
+				"concurrency": 1,
+				"confirm":     "29c120744f8d3789",
+			},
+		},
+
+		// bulk_update_issues - Validation
+		{
+			Name: "BulkUpdateIssuesInvalidAction",
+			Tool: "bulk_update_issues",
+			Input: map[string]interface{}{
+				"query":  "repo:" + OWNER + "/" + REPO + " is:open",
+				"action": "delete",
+			},
+		},
+		{
+			Name: "BulkUpdateIssuesMissingLabels",
+			Tool: "bulk_update_issues",
+			Input: map[string]interface{}{
+				"query":  "repo:" + OWNER + "/" + REPO + " is:open",
+				"action": "add_labels",
+			},
+		},
+		{
+			Name: "BulkUpdateIssuesMaxItemsTooLarge",
+			Tool: "bulk_update_issues",
+			Input: map[string]interface{}{
+				"query":     "repo:" + OWNER + "/" + REPO + " is:open",
+				"action":    "close",
+				"max_items": 500,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			RunTest(t, tc)
+		})
+	}
+}
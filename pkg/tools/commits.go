@@ -90,9 +90,15 @@ func RegisterCommitTools(s *Server) {
 		mcp.WithString("until",
 			mcp.Description("Only commits before this date will be returned (ISO 8601 format)"),
 		),
+		mcp.WithNumber("page",
+			mcp.Description("Fetch only this page (default: fetch pages automatically up to max_items)"),
+		),
 		mcp.WithNumber("per_page",
 			mcp.Description("Number of results per page (max 100, default 30)"),
 		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Maximum number of results to fetch across pages when page is not set (default: 100, max: 1000)"),
+		),
 	)
 
 	s.RegisterTool(listCommitsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -138,17 +144,14 @@ func RegisterCommitTools(s *Server) {
 			}
 		}
 
-		// Parse per_page
-		perPage := 30
-		if perPageVal, ok := request.Params.Arguments["per_page"].(float64); ok {
-			perPage = int(perPageVal)
-			if perPage <= 0 || perPage > 100 {
-				return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("per_page must be between 1 and 100"))), nil
-			}
+		// Parse pagination
+		pagination, paginationErr := parsePaginationOptions(request.Params.Arguments)
+		if paginationErr != nil {
+			return mcp.NewToolResultError(errors.FormatGitHubError(paginationErr)), nil
 		}
 
 		// Call the operation
-		result, err := commitOps.ListCommits(ctx, owner, repo, path, author, since, until, perPage)
+		result, err := commitOps.ListCommits(ctx, owner, repo, path, author, since, until, pagination)
 		if err != nil {
 			if ghErr, ok := err.(*errors.GitHubError); ok {
 				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
@@ -157,7 +160,8 @@ func RegisterCommitTools(s *Server) {
 		}
 
 		// Format the result as markdown
-		markdown := formatCommitListToMarkdown(result)
+		markdown := formatCommitListToMarkdown(result.Items)
+		markdown += formatTruncationNote(result)
 		return mcp.NewToolResultText(markdown), nil
 	})
 
@@ -355,6 +359,15 @@ func RegisterCommitTools(s *Server) {
 			mcp.Required(),
 			mcp.Description("Commit SHA"),
 		),
+		mcp.WithNumber("page",
+			mcp.Description("Fetch only this page (default: fetch pages automatically up to max_items)"),
+		),
+		mcp.WithNumber("per_page",
+			mcp.Description("Number of results per page (max 100, default 30)"),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Maximum number of results to fetch across pages when page is not set (default: 100, max: 1000)"),
+		),
 	)
 
 	s.RegisterTool(listCommitCommentsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -374,8 +387,14 @@ func RegisterCommitTools(s *Server) {
 			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("sha must be a string"))), nil
 		}
 
+		// Parse pagination
+		pagination, paginationErr := parsePaginationOptions(request.Params.Arguments)
+		if paginationErr != nil {
+			return mcp.NewToolResultError(errors.FormatGitHubError(paginationErr)), nil
+		}
+
 		// Call the operation
-		result, err := commitOps.ListCommitComments(ctx, owner, repo, sha)
+		result, err := commitOps.ListCommitComments(ctx, owner, repo, sha, pagination)
 		if err != nil {
 			if ghErr, ok := err.(*errors.GitHubError); ok {
 				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
@@ -384,7 +403,8 @@ func RegisterCommitTools(s *Server) {
 		}
 
 		// Format the result as markdown
-		markdown := formatCommitCommentListToMarkdown(result)
+		markdown := formatCommitCommentListToMarkdown(result.Items)
+		markdown += formatTruncationNote(result)
 		return mcp.NewToolResultText(markdown), nil
 	})
 
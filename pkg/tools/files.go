@@ -2,16 +2,27 @@ package tools
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strings"
 
-	"github.com/google/go-github/v69/github"
 	"github.com/mark3labs/mcp-go/mcp"
 
 	"github.com/geropl/github-mcp-go/pkg/errors"
 	ghclient "github.com/geropl/github-mcp-go/pkg/github"
 )
 
+// Size limits of get_file_contents; larger files are described by their metadata
+const (
+	// maxImageSize is the largest image get_file_contents returns as image content
+	maxImageSize = 5 * 1024 * 1024
+	// maxTextSize is the largest other file get_file_contents returns whole
+	maxTextSize = 1024 * 1024
+	// maxExcerptFileSize is the largest file get_file_contents selects lines from
+	maxExcerptFileSize = 10 * 1024 * 1024
+)
+
 // RegisterFileTools registers file-related tools
 func RegisterFileTools(s *Server) {
 	client := s.GetClient()
@@ -20,7 +31,7 @@ func RegisterFileTools(s *Server) {
 
 	// Register get_file_contents tool
 	getFileContentsTool := mcp.NewTool("get_file_contents",
-		mcp.WithDescription("Get the contents of a file or directory in a GitHub repository"),
+		mcp.WithDescription("Get the contents of a file or directory in a GitHub repository. Binary files are described by their metadata, images are returned as image content"),
 		mcp.WithString("owner",
 			mcp.Required(),
 			mcp.Description("Repository owner (username or organization)"),
@@ -36,6 +47,24 @@ func RegisterFileTools(s *Server) {
 		mcp.WithString("branch",
 			mcp.Description("Branch name (default: repository's default branch)"),
 		),
+		mcp.WithBoolean("resolve_lfs",
+			mcp.Description("Fetch the object behind a Git LFS pointer file through the LFS batch API instead of describing the pointer (default: false)"),
+		),
+		mcp.WithNumber("start_line",
+			mcp.Description("First line to return, 1-based (default: 1)"),
+		),
+		mcp.WithNumber("end_line",
+			mcp.Description("Last line to return, inclusive (default: end of file)"),
+		),
+		mcp.WithString("pattern",
+			mcp.Description("Regular expression (RE2 syntax, e.g. 'func \\w+Handler' or '(?i)todo'); only matching lines and their context are returned, searched within start_line and end_line"),
+		),
+		mcp.WithNumber("context_lines",
+			mcp.Description(fmt.Sprintf("Lines of context around each pattern match (default: 3, max: %d)", ghclient.MaxContextLines)),
+		),
+		mcp.WithBoolean("line_numbers",
+			mcp.Description("Prefix lines with their numbers; always on with start_line, end_line or pattern (default: false)"),
+		),
 	)
 
 	s.RegisterTool(getFileContentsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -62,8 +91,42 @@ func RegisterFileTools(s *Server) {
 			}
 		}
 
+		resolveLFS, _ := request.Params.Arguments["resolve_lfs"].(bool)
+
+		// Parse the line selection
+		excerptOpts := ghclient.ExcerptOptions{ContextLines: 3}
+		if startLine, ok := request.Params.Arguments["start_line"].(float64); ok {
+			excerptOpts.StartLine = int(startLine)
+		}
+		if endLine, ok := request.Params.Arguments["end_line"].(float64); ok {
+			excerptOpts.EndLine = int(endLine)
+		}
+		excerptOpts.Pattern, _ = request.Params.Arguments["pattern"].(string)
+		if contextLines, ok := request.Params.Arguments["context_lines"].(float64); ok {
+			excerptOpts.ContextLines = int(contextLines)
+		}
+		lineNumbers, _ := request.Params.Arguments["line_numbers"].(bool)
+		excerpt := lineNumbers || excerptOpts.StartLine != 0 || excerptOpts.EndLine != 0 || excerptOpts.Pattern != ""
+		if excerpt {
+			if err := excerptOpts.Validate(); err != nil {
+				if ghErr, ok := err.(*errors.GitHubError); ok {
+					return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+				}
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+
+		// Only fetch what can be returned: images up to the image limit, and larger text files only
+		// to select lines from them
+		maxSize := maxTextSize
+		if excerpt {
+			maxSize = maxExcerptFileSize
+		} else if strings.HasPrefix(ghclient.DetectMIMEType(path, nil), "image/") {
+			maxSize = maxImageSize
+		}
+
 		// Call the operation
-		result, err := fileOps.GetFileContents(ctx, owner, repo, path, branch)
+		file, directory, err := fileOps.ReadFile(ctx, owner, repo, path, branch, resolveLFS, maxSize)
 		if err != nil {
 			if ghErr, ok := err.(*errors.GitHubError); ok {
 				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
@@ -71,37 +134,9 @@ func RegisterFileTools(s *Server) {
 			return mcp.NewToolResultError(fmt.Sprintf("Error getting file contents: %v", err)), nil
 		}
 
-		// Handle different result types
-		switch content := result.(type) {
-		case *github.RepositoryContent:
-			// It's a file
-			decodedContent, err := fileOps.DecodeFileContent(content)
-			if err != nil {
-				return mcp.NewToolResultError(fmt.Sprintf("Error decoding file content: %v", err)), nil
-			}
-
-			// Create a response with file metadata and content
-			response := map[string]interface{}{
-				"type":         "file",
-				"name":         content.GetName(),
-				"path":         content.GetPath(),
-				"sha":          content.GetSHA(),
-				"size":         content.GetSize(),
-				"url":          content.GetURL(),
-				"html_url":     content.GetHTMLURL(),
-				"git_url":      content.GetGitURL(),
-				"download_url": content.GetDownloadURL(),
-				"content":      decodedContent,
-			}
-
-			// Format the result as markdown
-			markdown := formatFileContentToMarkdown(response)
-			return mcp.NewToolResultText(markdown), nil
-
-		case []*github.RepositoryContent:
-			// It's a directory
+		if directory != nil {
 			var dirContents []map[string]interface{}
-			for _, item := range content {
+			for _, item := range directory {
 				dirContents = append(dirContents, map[string]interface{}{
 					"type":         item.GetType(),
 					"name":         item.GetName(),
@@ -124,10 +159,129 @@ func RegisterFileTools(s *Server) {
 			// Format the result as markdown
 			markdown := formatDirectoryContentToMarkdown(response)
 			return mcp.NewToolResultText(markdown), nil
+		}
+
+		if file.TooLarge {
+			return mcp.NewToolResultText(formatLargeFileToMarkdown(file, maxSize, excerpt)), nil
+		}
+
+		if excerpt && (file.Binary || (file.LFS != nil && !file.LFSResolved)) {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("start_line, end_line, pattern and line_numbers only apply to text files"))), nil
+		}
+
+		// Git LFS pointers that were not resolved only carry metadata
+		if file.LFS != nil && !file.LFSResolved {
+			return mcp.NewToolResultText(formatLFSPointerToMarkdown(file)), nil
+		}
+
+		// Binary content is not shown, except for images small enough to return as image content
+		if file.Binary {
+			markdown := formatBinaryFileToMarkdown(file)
+			if file.IsImage() && len(file.Content) <= maxImageSize {
+				return mcp.NewToolResultImage(markdown, base64.StdEncoding.EncodeToString(file.Content), file.MIMEType), nil
+			}
+			return mcp.NewToolResultText(markdown), nil
+		}
+
+		if excerpt {
+			lines, err := ghclient.ExtractLines(string(file.Content), excerptOpts)
+			if err != nil {
+				if ghErr, ok := err.(*errors.GitHubError); ok {
+					return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("Error selecting lines: %v", err)), nil
+			}
+			return mcp.NewToolResultText(formatFileExcerptToMarkdown(file, lines, excerptOpts.Pattern)), nil
+		}
+
+		// Create a response with file metadata and content
+		response := map[string]interface{}{
+			"type":         "file",
+			"name":         file.Name,
+			"path":         file.Path,
+			"sha":          file.SHA,
+			"size":         file.Size,
+			"html_url":     file.HTMLURL,
+			"download_url": file.DownloadURL,
+			"content":      string(file.Content),
+		}
+
+		// Format the result as markdown
+		markdown := formatFileContentToMarkdown(response)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register get_repository_tree tool
+	getRepositoryTreeTool := mcp.NewTool("get_repository_tree",
+		mcp.WithDescription("List the files and directories of a repository recursively in one call, with sizes, glob filters and a depth limit"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithString("ref",
+			mcp.Description("Branch, tag or commit SHA (default: repository's default branch)"),
+		),
+		mcp.WithString("path",
+			mcp.Description("Directory to list (default: the repository root)"),
+		),
+		mcp.WithString("include",
+			mcp.Description("Comma-separated glob patterns of files to include, e.g. 'pkg/**/*.go'. Patterns without '/' match the file name in any directory"),
+		),
+		mcp.WithString("exclude",
+			mcp.Description("Comma-separated glob patterns of paths to exclude, e.g. '*_test.go,testdata/**'"),
+		),
+		mcp.WithNumber("max_depth",
+			mcp.Description("How many directory levels below path to list; 1 lists direct children only (default: unlimited)"),
+		),
+		mcp.WithNumber("max_entries",
+			mcp.Description(fmt.Sprintf("Maximum number of entries to return (default: %d, max: %d)", ghclient.DefaultTreeEntries, ghclient.MaxTreeEntries)),
+		),
+	)
+
+	s.RegisterTool(getRepositoryTreeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		ref, _ := request.Params.Arguments["ref"].(string)
+
+		var opts ghclient.TreeOptions
+		opts.Path, _ = request.Params.Arguments["path"].(string)
+		if includeVal, ok := request.Params.Arguments["include"].(string); ok {
+			opts.Paths.Include = splitCommaList(includeVal)
+		}
+		if excludeVal, ok := request.Params.Arguments["exclude"].(string); ok {
+			opts.Paths.Exclude = splitCommaList(excludeVal)
+		}
+		if maxDepth, ok := request.Params.Arguments["max_depth"].(float64); ok {
+			opts.MaxDepth = int(maxDepth)
+		}
+		if maxEntries, ok := request.Params.Arguments["max_entries"].(float64); ok {
+			opts.MaxEntries = int(maxEntries)
+		}
 
-		default:
-			return mcp.NewToolResultError("Unexpected response type from GitHub API"), nil
+		// Call the operation
+		tree, err := fileOps.GetRepositoryTree(ctx, owner, repo, ref, opts)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting repository tree: %v", err)), nil
 		}
+
+		// Format the result as markdown
+		return mcp.NewToolResultText(formatRepositoryTreeToMarkdown(owner, repo, tree)), nil
 	})
 
 	// Register create_or_update_file tool
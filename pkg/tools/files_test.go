@@ -58,6 +58,78 @@ func TestFiles(t *testing.T) {
 			},
 		},
 
+		// get_file_contents - Large, binary and LFS files
+		{
+			Name: "GetLargeTextFile",
+			Tool: "get_file_contents",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+				"path":  "testdata/large.log",
+			},
+		},
+		{
+			Name: "GetBinaryFile",
+			Tool: "get_file_contents",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+				"path":  "testdata/archive.bin",
+			},
+		},
+		{
+			Name: "GetImageFile",
+			Tool: "get_file_contents",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+				"path":  "testdata/pixel.png",
+			},
+		},
+		{
+			Name: "GetLFSPointer",
+			Tool: "get_file_contents",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+				"path":  "testdata/model.onnx",
+			},
+		},
+
+		// get_file_contents - Line selection
+		{
+			Name: "GetFileContentsLineRange",
+			Tool: "get_file_contents",
+			Input: map[string]interface{}{
+				"owner":      FILE_OWNER,
+				"repo":       FILE_REPO,
+				"path":       "README.md",
+				"start_line": 3,
+				"end_line":   3,
+			},
+		},
+		{
+			Name: "GetFileContentsPattern",
+			Tool: "get_file_contents",
+			Input: map[string]interface{}{
+				"owner":         FILE_OWNER,
+				"repo":          FILE_REPO,
+				"path":          "README.md",
+				"pattern":       "(?i)what",
+				"context_lines": 1,
+			},
+		},
+		{
+			Name: "GetFileContentsInvalidPattern",
+			Tool: "get_file_contents",
+			Input: map[string]interface{}{
+				"owner":   FILE_OWNER,
+				"repo":    FILE_REPO,
+				"path":    "README.md",
+				"pattern": "(",
+			},
+		},
+
 		// get_file_contents - Error Cases
 		{
 			Name: "GetNonExistentFile",
@@ -335,6 +407,48 @@ func TestFiles(t *testing.T) {
 				"files":   `[{"path": "test-file1.md", "content": "# Test File 1"}]`,
 			},
 		},
+
+		// get_repository_tree - Happy Path
+		{
+			Name: "GetRepositoryTree",
+			Tool: "get_repository_tree",
+			Input: map[string]interface{}{
+				"owner": FILE_OWNER,
+				"repo":  FILE_REPO,
+				"ref":   "main",
+			},
+		},
+		{
+			Name: "GetRepositoryTreeFiltered",
+			Tool: "get_repository_tree",
+			Input: map[string]interface{}{
+				"owner":   FILE_OWNER,
+				"repo":    FILE_REPO,
+				"ref":     "main",
+				"path":    "testdata",
+				"include": "*.md",
+			},
+		},
+
+		// get_repository_tree - Validation
+		{
+			Name: "GetRepositoryTreeInvalidGlob",
+			Tool: "get_repository_tree",
+			Input: map[string]interface{}{
+				"owner":   FILE_OWNER,
+				"repo":    FILE_REPO,
+				"include": "[",
+			},
+		},
+		{
+			Name: "GetRepositoryTreeMaxEntriesTooLarge",
+			Tool: "get_repository_tree",
+			Input: map[string]interface{}{
+				"owner":       FILE_OWNER,
+				"repo":        FILE_REPO,
+				"max_entries": 50000,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
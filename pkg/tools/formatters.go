@@ -15,6 +15,7 @@ func formatPullRequestToMarkdown(pr *github.PullRequest) string {
 	md += fmt.Sprintf("**Number:** #%d  \n", pr.GetNumber())
 	md += fmt.Sprintf("**State:** %s  \n", pr.GetState())
 	md += fmt.Sprintf("**Created:** %s  \n", pr.GetCreatedAt().Format(time.RFC1123))
+	md += fmt.Sprintf("**Updated:** %s  \n", pr.GetUpdatedAt().Format(time.RFC3339))
 	md += fmt.Sprintf("**URL:** %s  \n\n", pr.GetHTMLURL())
 
 	if pr.GetBody() != "" {
@@ -29,12 +30,71 @@ func formatPullRequestToMarkdown(pr *github.PullRequest) string {
 		md += fmt.Sprintf("- **Mergeable:** %t  \n", *pr.Mergeable)
 	}
 	md += fmt.Sprintf("- **Draft:** %t  \n", pr.GetDraft())
+	if pr.AutoMerge != nil {
+		md += fmt.Sprintf("- **Auto-merge:** %s (enabled by %s)  \n", pr.AutoMerge.GetMergeMethod(), pr.AutoMerge.GetEnabledBy().GetLogin())
+	}
 	md += fmt.Sprintf("- **Changes:** +%d/-%d in %d files  \n",
 		pr.GetAdditions(), pr.GetDeletions(), pr.GetChangedFiles())
 
 	return md
 }
 
+// formatPullRequestListToMarkdown converts a list of GitHub PullRequests to a compact markdown table
+func formatPullRequestListToMarkdown(prs []*github.PullRequest) string {
+	md := fmt.Sprintf("# Pull Requests\n\n")
+
+	if len(prs) == 0 {
+		md += "No pull requests found.\n"
+		return md
+	}
+
+	md += fmt.Sprintf("Found %d pull requests.\n\n", len(prs))
+
+	md += "| # | Title | State | Author | Head → Base | Labels | Updated |\n"
+	md += "|---|-------|-------|--------|-------------|--------|---------|\n"
+	for _, pr := range prs {
+		state := pr.GetState()
+		if pr.GetDraft() {
+			state += " (draft)"
+		}
+		if !pr.GetMergedAt().IsZero() {
+			state = "merged"
+		}
+
+		labels := make([]string, 0, len(pr.Labels))
+		for _, label := range pr.Labels {
+			labels = append(labels, label.GetName())
+		}
+
+		md += fmt.Sprintf("| [#%d](%s) | %s | %s | %s | %s → %s | %s | %s |\n",
+			pr.GetNumber(),
+			pr.GetHTMLURL(),
+			escapeTableCell(truncateString(pr.GetTitle(), 80)),
+			state,
+			pr.GetUser().GetLogin(),
+			escapeTableCell(pr.GetHead().GetRef()),
+			escapeTableCell(pr.GetBase().GetRef()),
+			escapeTableCell(strings.Join(labels, ", ")),
+			pr.GetUpdatedAt().Format("2006-01-02"),
+		)
+	}
+	md += "\n"
+
+	return md
+}
+
+// formatPullRequestMergeResultToMarkdown converts the result of merging a pull request to markdown
+func formatPullRequestMergeResultToMarkdown(number int, mergeMethod string, result *github.PullRequestMergeResult) string {
+	md := fmt.Sprintf("# Pull Request #%d Merged\n\n", number)
+
+	md += fmt.Sprintf("**Merged:** %t  \n", result.GetMerged())
+	md += fmt.Sprintf("**Method:** %s  \n", mergeMethod)
+	md += fmt.Sprintf("**SHA:** %s  \n", result.GetSHA())
+	md += fmt.Sprintf("**Message:** %s  \n", result.GetMessage())
+
+	return md
+}
+
 // formatRepositoryToMarkdown converts a GitHub Repository to markdown
 func formatRepositoryToMarkdown(repo *github.Repository) string {
 	md := fmt.Sprintf("# Repository: %s\n\n", repo.GetFullName())
@@ -89,28 +149,134 @@ func formatFileContentToMarkdown(content map[string]interface{}) string {
 
 	md += fmt.Sprintf("**Path:** %s  \n", content["path"])
 	md += fmt.Sprintf("**Size:** %v bytes  \n", content["size"])
+	md += fmt.Sprintf("**SHA:** %s  \n", content["sha"])
 	md += fmt.Sprintf("**URL:** %s  \n\n", content["html_url"])
 
 	if fileContent, ok := content["content"].(string); ok && fileContent != "" {
-		// Determine if we should use a code block based on file extension
-		path := content["path"].(string)
-		extension := ""
-		if lastDot := strings.LastIndex(path, "."); lastDot >= 0 {
-			extension = path[lastDot+1:]
-		}
-
 		// Add content with appropriate formatting
 		md += "## Content\n\n"
-		if extension != "" {
-			md += fmt.Sprintf("```%s\n%s\n```\n", extension, fileContent)
+		md += fmt.Sprintf("```%s\n%s\n```\n", fileExtension(content["path"].(string)), fileContent)
+	}
+
+	return md
+}
+
+// fileExtension returns the extension of a path without the dot, to label code blocks
+func fileExtension(path string) string {
+	if lastDot := strings.LastIndex(path, "."); lastDot >= 0 {
+		return path[lastDot+1:]
+	}
+	return ""
+}
+
+// formatFileExcerptToMarkdown converts selected lines of a file to markdown with line numbers.
+// With a pattern, matching lines are marked with ":" and context lines with "-", as grep does.
+func formatFileExcerptToMarkdown(file *ghClient.FileData, excerpt *ghClient.FileExcerpt, pattern string) string {
+	md := fmt.Sprintf("# File: %s\n\n", file.Path)
+
+	md += fmt.Sprintf("**Path:** %s  \n", file.Path)
+	md += fmt.Sprintf("**Size:** %d bytes, %d lines  \n", file.Size, excerpt.TotalLines)
+	md += fmt.Sprintf("**SHA:** %s  \n", file.SHA)
+	md += fmt.Sprintf("**URL:** %s  \n", file.HTMLURL)
+	if pattern != "" {
+		md += fmt.Sprintf("**Pattern:** `%s`  \n", pattern)
+		md += fmt.Sprintf("**Matching lines:** %d  \n", excerpt.Matches)
+	} else if len(excerpt.Lines) > 0 {
+		md += fmt.Sprintf("**Lines:** %d-%d  \n", excerpt.Lines[0].Number, excerpt.Lines[len(excerpt.Lines)-1].Number)
+	}
+	md += "\n"
+
+	if len(excerpt.Lines) == 0 {
+		if pattern != "" {
+			md += "No lines match.\n"
 		} else {
-			md += fmt.Sprintf("```\n%s\n```\n", fileContent)
+			md += "The file is empty.\n"
+		}
+		return md
+	}
+
+	width := len(fmt.Sprint(excerpt.Lines[len(excerpt.Lines)-1].Number))
+	md += fmt.Sprintf("```%s\n", fileExtension(file.Path))
+	for j, line := range excerpt.Lines {
+		separator := ":"
+		if pattern != "" {
+			if j > 0 && line.Number != excerpt.Lines[j-1].Number+1 {
+				md += "--\n"
+			}
+			if !line.Match {
+				separator = "-"
+			}
 		}
+		md += fmt.Sprintf("%*d%s %s\n", width, line.Number, separator, line.Text)
+	}
+	md += "```\n"
+
+	if excerpt.Truncated {
+		md += fmt.Sprintf("\n*Only the first %d matches are shown.*\n", ghClient.MaxLineMatches)
 	}
 
 	return md
 }
 
+// formatBinaryFileToMarkdown describes a binary file without its content
+func formatBinaryFileToMarkdown(file *ghClient.FileData) string {
+	md := fmt.Sprintf("# File: %s\n\n", file.Path)
+
+	md += fmt.Sprintf("**Path:** %s  \n", file.Path)
+	md += fmt.Sprintf("**Size:** %d bytes  \n", len(file.Content))
+	md += fmt.Sprintf("**Type:** %s (binary)  \n", file.MIMEType)
+	md += fmt.Sprintf("**SHA:** %s  \n", file.SHA)
+	if file.LFSResolved {
+		md += fmt.Sprintf("**Git LFS object:** %s  \n", file.LFS.OID)
+	}
+	md += fmt.Sprintf("**URL:** %s  \n", file.HTMLURL)
+	if file.DownloadURL != "" {
+		md += fmt.Sprintf("**Download URL:** %s  \n", file.DownloadURL)
+	}
+
+	md += "\nBinary content is not shown.\n"
+	return md
+}
+
+// formatLargeFileToMarkdown describes a file over the size limit of get_file_contents
+func formatLargeFileToMarkdown(file *ghClient.FileData, limit int, excerpt bool) string {
+	md := fmt.Sprintf("# File: %s\n\n", file.Path)
+
+	md += fmt.Sprintf("**Path:** %s  \n", file.Path)
+	md += fmt.Sprintf("**Size:** %d bytes  \n", file.Size)
+	md += fmt.Sprintf("**SHA:** %s  \n", file.SHA)
+	md += fmt.Sprintf("**URL:** %s  \n", file.HTMLURL)
+	if file.DownloadURL != "" {
+		md += fmt.Sprintf("**Download URL:** %s  \n", file.DownloadURL)
+	}
+
+	md += fmt.Sprintf("\nThe file is larger than the %d bytes get_file_contents reads", limit)
+	switch {
+	case excerpt:
+		md += ", even to select lines. Use the download URL to fetch it.\n"
+	case strings.HasPrefix(file.MIMEType, "image/"):
+		md += " for images. Use the download URL to fetch it.\n"
+	default:
+		md += " at once. If it is text, select lines with start_line and end_line, or search it with pattern.\n"
+	}
+	return md
+}
+
+// formatLFSPointerToMarkdown describes a Git LFS pointer file
+func formatLFSPointerToMarkdown(file *ghClient.FileData) string {
+	md := fmt.Sprintf("# File: %s\n\n", file.Path)
+
+	md += fmt.Sprintf("**Path:** %s  \n", file.Path)
+	md += "**Stored in:** Git LFS  \n"
+	md += fmt.Sprintf("**Object size:** %d bytes  \n", file.LFS.Size)
+	md += fmt.Sprintf("**Object ID:** sha256:%s  \n", file.LFS.OID)
+	md += fmt.Sprintf("**Type:** %s  \n", file.MIMEType)
+	md += fmt.Sprintf("**URL:** %s  \n", file.HTMLURL)
+
+	md += "\nThe repository only contains a pointer to this file. Set resolve_lfs to fetch its content.\n"
+	return md
+}
+
 // formatDirectoryContentToMarkdown converts GitHub directory content to markdown
 func formatDirectoryContentToMarkdown(content map[string]interface{}) string {
 	md := fmt.Sprintf("# Directory: %s\n\n", content["path"])
@@ -137,6 +303,59 @@ func formatDirectoryContentToMarkdown(content map[string]interface{}) string {
 	return md
 }
 
+// formatRepositoryTreeToMarkdown converts a repository tree listing to markdown, one path per line
+func formatRepositoryTreeToMarkdown(owner, repo string, tree *ghClient.RepositoryTree) string {
+	location := fmt.Sprintf("%s/%s@%s", owner, repo, tree.Ref)
+	if tree.Path != "" {
+		location += ":" + tree.Path
+	}
+	md := fmt.Sprintf("# Tree: %s\n\n", location)
+
+	md += fmt.Sprintf("**Tree SHA:** %s  \n", tree.SHA)
+	md += fmt.Sprintf("**Entries:** %d files (%s), %d directories  \n\n", tree.Files, formatSize(tree.TotalSize), tree.Directories)
+
+	if tree.Incomplete {
+		md += "*GitHub truncated the listing of this large tree and the contents of some directories could not be fetched. Narrow the listing with path or max_depth.*\n\n"
+	}
+
+	if len(tree.Entries) == 0 {
+		md += "No entries found.\n"
+		return md
+	}
+
+	md += "```\n"
+	for _, entry := range tree.Entries {
+		switch entry.Type {
+		case "tree":
+			md += entry.Path + "/\n"
+		case "commit":
+			md += fmt.Sprintf("%s @ %.7s (submodule)\n", entry.Path, entry.SHA)
+		default:
+			md += fmt.Sprintf("%s (%s)\n", entry.Path, formatSize(int64(entry.Size)))
+		}
+	}
+	md += "```\n"
+
+	if tree.Truncated {
+		md += fmt.Sprintf("\n*Showing the first %d of %d entries. Narrow the listing with path, include, exclude or max_depth, or raise max_entries.*\n",
+			len(tree.Entries), tree.Files+tree.Directories)
+	}
+
+	return md
+}
+
+// formatSize formats a number of bytes for humans
+func formatSize(size int64) string {
+	switch {
+	case size < 1024:
+		return fmt.Sprintf("%d B", size)
+	case size < 1024*1024:
+		return fmt.Sprintf("%.1f KB", float64(size)/1024)
+	default:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1024*1024))
+	}
+}
+
 // formatFileUpdateToMarkdown converts GitHub file update result to markdown
 func formatFileUpdateToMarkdown(result *github.RepositoryContentResponse) string {
 	md := fmt.Sprintf("# File Update: %s\n\n", result.GetContent().GetPath())
@@ -418,6 +637,27 @@ func formatCommitCommentListToMarkdown(comments []*github.RepositoryComment) str
 	return md
 }
 
+// formatPullRequestBranchUpdateToMarkdown converts the result of updating a pull request branch to markdown
+func formatPullRequestBranchUpdateToMarkdown(number int, message string) string {
+	md := fmt.Sprintf("# Pull Request Branch Update (#%d)\n\n", number)
+	md += fmt.Sprintf("%s  \n", message)
+	md += "The head SHA changes once GitHub has merged the base branch; check it with get_pull_request_status.\n"
+	return md
+}
+
+// formatAutoMergeToMarkdown converts the auto-merge settings of a pull request to markdown
+func formatAutoMergeToMarkdown(number int, autoMerge *ghClient.AutoMergeRequest) string {
+	if autoMerge == nil {
+		return fmt.Sprintf("# Pull Request Merged (#%d)\n\nAuto-merge was not needed: all requirements were already met and GitHub merged the pull request right away.\n", number)
+	}
+
+	md := fmt.Sprintf("# Auto-merge Enabled (#%d)\n\n", number)
+	md += fmt.Sprintf("**Method:** %s  \n", strings.ToLower(autoMerge.MergeMethod))
+	md += fmt.Sprintf("**Enabled By:** %s  \n", autoMerge.EnabledBy.Login)
+	md += fmt.Sprintf("**Enabled At:** %s  \n", autoMerge.EnabledAt.Format(time.RFC3339))
+	return md
+}
+
 // formatPullRequestDiffToMarkdown formats a pull request diff as markdown
 func formatPullRequestDiffToMarkdown(number int, diff string) string {
 	md := fmt.Sprintf("# Pull Request Diff (#%d)\n\n", number)
@@ -433,11 +673,186 @@ func formatPullRequestDiffToMarkdown(number int, diff string) string {
 	return md
 }
 
+// formatPullRequestFilesToMarkdown converts the files changed by a pull request to markdown.
+// patchMode is one of "none", "truncated" or "full".
+func formatPullRequestFilesToMarkdown(number int, files []*github.CommitFile, patchMode string) string {
+	md := fmt.Sprintf("# Pull Request Files (#%d)\n\n", number)
+
+	if len(files) == 0 {
+		md += "No files found.\n"
+		return md
+	}
+
+	var additions, deletions int
+	for _, file := range files {
+		additions += file.GetAdditions()
+		deletions += file.GetDeletions()
+	}
+	md += fmt.Sprintf("Found %d files (+%d -%d).\n\n", len(files), additions, deletions)
+
+	md += "| File | Status | + | - | SHA |\n"
+	md += "|------|--------|---|---|-----|\n"
+	for _, file := range files {
+		name := file.GetFilename()
+		if file.GetPreviousFilename() != "" {
+			name = fmt.Sprintf("%s → %s", file.GetPreviousFilename(), name)
+		}
+		md += fmt.Sprintf("| %s | %s | %d | %d | %s |\n",
+			escapeTableCell(name),
+			file.GetStatus(),
+			file.GetAdditions(),
+			file.GetDeletions(),
+			truncateString(file.GetSHA(), 7),
+		)
+	}
+	md += "\n"
+
+	if patchMode == "none" {
+		return md
+	}
+
+	// Per-file patches
+	const maxPatchLines = 50
+	for _, file := range files {
+		md += fmt.Sprintf("## %s\n\n", file.GetFilename())
+
+		patch := file.GetPatch()
+		if patch == "" {
+			// GitHub omits the patch for binary files and very large diffs
+			md += "No patch available (binary file or diff too large).\n\n"
+			continue
+		}
+
+		if patchMode == "truncated" {
+			lines := strings.Split(patch, "\n")
+			if len(lines) > maxPatchLines {
+				patch = strings.Join(lines[:maxPatchLines], "\n") +
+					fmt.Sprintf("\n... (%d more lines, use patch=full and a path filter to see all)", len(lines)-maxPatchLines)
+			}
+		}
+
+		md += "```diff\n" + patch + "\n```\n\n"
+	}
+
+	return md
+}
+
+// formatPullRequestStatusToMarkdown converts the aggregated readiness status of a pull request to markdown
+func formatPullRequestStatusToMarkdown(status *ghClient.PullRequestStatus) string {
+	pr := status.PullRequest
+	md := fmt.Sprintf("# Pull Request Status (#%d)\n\n", pr.GetNumber())
+
+	// Verdict first, so the answer does not have to be pieced together from the sections below
+	if len(status.Blockers) == 0 {
+		md += "**Can merge:** yes  \n\n"
+	} else {
+		md += "**Can merge:** no  \n\n"
+		md += "## Blockers\n\n"
+		for _, blocker := range status.Blockers {
+			md += fmt.Sprintf("- %s\n", blocker)
+		}
+		md += "\n"
+	}
+
+	md += "## Mergeability\n\n"
+	md += fmt.Sprintf("**State:** %s  \n", pr.GetState())
+	md += fmt.Sprintf("**Draft:** %t  \n", pr.GetDraft())
+	if pr.Mergeable != nil {
+		md += fmt.Sprintf("**Mergeable:** %t  \n", pr.GetMergeable())
+	} else {
+		md += "**Mergeable:** unknown  \n"
+	}
+	md += fmt.Sprintf("**Mergeable State:** %s  \n", pr.GetMergeableState())
+	md += fmt.Sprintf("**Head:** %s (%s)  \n", pr.GetHead().GetRef(), pr.GetHead().GetSHA())
+	md += fmt.Sprintf("**Base:** %s  \n", pr.GetBase().GetRef())
+	if status.BehindBy >= 0 {
+		md += fmt.Sprintf("**Behind Base By:** %d commits  \n", status.BehindBy)
+	} else {
+		md += "**Behind Base By:** unknown  \n"
+	}
+	md += "\n"
+
+	md += "## Reviews\n\n"
+	if status.ReviewDecision != "" {
+		md += fmt.Sprintf("**Review Decision:** %s  \n", status.ReviewDecision)
+	} else {
+		md += "**Review Decision:** no review required  \n"
+	}
+	var requested []string
+	for _, user := range pr.RequestedReviewers {
+		requested = append(requested, user.GetLogin())
+	}
+	for _, team := range pr.RequestedTeams {
+		requested = append(requested, "@"+team.GetSlug())
+	}
+	if len(requested) > 0 {
+		md += fmt.Sprintf("**Pending Reviewers:** %s  \n", strings.Join(requested, ", "))
+	}
+	for _, review := range status.LatestReviews {
+		md += fmt.Sprintf("- %s: %s\n", review.GetUser().GetLogin(), review.GetState())
+	}
+	md += "\n"
+
+	passed, failed, pending := status.CheckRunCounts()
+	md += fmt.Sprintf("## Check Runs (%d passed, %d failed, %d pending)\n\n", passed, failed, pending)
+	if len(status.CheckRuns) == 0 {
+		md += "No check runs found.\n\n"
+	} else {
+		md += "| Name | Status | Conclusion |\n"
+		md += "|------|--------|------------|\n"
+		for _, run := range status.CheckRuns {
+			md += fmt.Sprintf("| %s | %s | %s |\n", escapeTableCell(run.GetName()), run.GetStatus(), run.GetConclusion())
+		}
+		md += "\n"
+	}
+
+	if combined := status.CombinedStatus; combined.GetTotalCount() > 0 {
+		md += fmt.Sprintf("## Commit Statuses (%s)\n\n", combined.GetState())
+		for _, s := range combined.Statuses {
+			md += fmt.Sprintf("- %s: %s", s.GetContext(), s.GetState())
+			if s.GetDescription() != "" {
+				md += fmt.Sprintf(" - %s", s.GetDescription())
+			}
+			md += "\n"
+		}
+		md += "\n"
+	}
+
+	if len(status.Warnings) > 0 {
+		md += "## Warnings\n\n"
+		for _, warning := range status.Warnings {
+			md += fmt.Sprintf("- %s\n", warning)
+		}
+		md += "\n"
+	}
+
+	return md
+}
+
+// formatIssueState formats the state of an issue with the reason it was closed or reopened
+func formatIssueState(issue *github.Issue) string {
+	if issue.GetStateReason() == "" {
+		return issue.GetState()
+	}
+	return fmt.Sprintf("%s (%s)", issue.GetState(), strings.ReplaceAll(issue.GetStateReason(), "_", " "))
+}
+
+// formatLockState describes a locked conversation and the reason it was locked
+func formatLockState(issue *github.Issue) string {
+	if issue.GetActiveLockReason() == "" {
+		return "yes"
+	}
+	return fmt.Sprintf("yes (%s)", issue.GetActiveLockReason())
+}
+
 // formatIssueToMarkdown converts a GitHub Issue to markdown
 func formatIssueToMarkdown(issue *github.Issue) string {
 	md := fmt.Sprintf("# Issue: %s\n\n", issue.GetTitle())
 	md += fmt.Sprintf("**Number:** #%d  \n", issue.GetNumber())
-	md += fmt.Sprintf("**State:** %s  \n", issue.GetState())
+	md += fmt.Sprintf("**State:** %s  \n", formatIssueState(issue))
+	if issue.GetLocked() {
+		md += fmt.Sprintf("**Locked:** %s  \n", formatLockState(issue))
+	}
 	md += fmt.Sprintf("**Created:** %s  \n", issue.GetCreatedAt().Format(time.RFC1123))
 
 	// Check if the issue is closed by checking if ClosedAt is not the zero value
@@ -483,6 +898,164 @@ func formatIssueToMarkdown(issue *github.Issue) string {
 	return md
 }
 
+// formatSubIssuesSummaryToMarkdown converts the sub-issue rollup of an issue to a markdown line
+func formatSubIssuesSummaryToMarkdown(summary *ghClient.SubIssuesSummary) string {
+	if summary == nil || summary.Total == 0 {
+		return ""
+	}
+	return fmt.Sprintf("**Sub-issues:** %d of %d completed (%d%%), see get_issue_hierarchy  \n",
+		summary.Completed, summary.Total, summary.PercentCompleted)
+}
+
+// formatIssueReference formats a short reference to an issue, e.g. "owner/repo#12: Title"
+func formatIssueReference(issue *ghClient.IssueReference) string {
+	return fmt.Sprintf("%s#%d: %s", issue.Repository.NameWithOwner, issue.Number, issue.Title)
+}
+
+// formatIssueHierarchyToMarkdown converts the parent and sub-issues of an issue to markdown
+func formatIssueHierarchyToMarkdown(hierarchy *ghClient.IssueHierarchy) string {
+	issue := hierarchy.Issue
+	md := fmt.Sprintf("# Issue Hierarchy: %s\n\n", formatIssueReference(&issue))
+	md += fmt.Sprintf("**State:** %s  \n", strings.ToLower(issue.State))
+	md += fmt.Sprintf("**URL:** %s  \n", issue.URL)
+	if hierarchy.Parent != nil {
+		md += fmt.Sprintf("**Parent:** %s (%s)  \n", formatIssueReference(hierarchy.Parent), strings.ToLower(hierarchy.Parent.State))
+	} else {
+		md += "**Parent:** none  \n"
+	}
+
+	summary := issue.SubIssuesSummary
+	if summary.Total == 0 {
+		md += "\nNo sub-issues.\n"
+		return md
+	}
+	md += fmt.Sprintf("**Progress:** %d of %d sub-issues completed (%d%%)  \n\n", summary.Completed, summary.Total, summary.PercentCompleted)
+
+	md += "## Sub-issues\n\n"
+	md += "| Issue | Title | State | Sub-issues |\n"
+	md += "|-------|-------|-------|------------|\n"
+	for _, sub := range hierarchy.SubIssues {
+		state := strings.ToLower(sub.State)
+		if sub.StateReason != "" && sub.StateReason != "COMPLETED" {
+			state += fmt.Sprintf(" (%s)", strings.ToLower(sub.StateReason))
+		}
+		progress := "-"
+		if sub.SubIssuesSummary.Total > 0 {
+			progress = fmt.Sprintf("%d/%d (%d%%)", sub.SubIssuesSummary.Completed, sub.SubIssuesSummary.Total, sub.SubIssuesSummary.PercentCompleted)
+		}
+		md += fmt.Sprintf("| %s#%d | %s | %s | %s |\n",
+			sub.Repository.NameWithOwner, sub.Number, escapeTableCell(sub.Title), state, progress)
+	}
+	md += "\n"
+	if hierarchy.TotalSubIssues > len(hierarchy.SubIssues) {
+		md += fmt.Sprintf("Showing the first %d of %d sub-issues.\n", len(hierarchy.SubIssues), hierarchy.TotalSubIssues)
+	}
+
+	return md
+}
+
+// formatClosingPullRequestsToMarkdown converts the pull requests that close an issue to markdown
+func formatClosingPullRequestsToMarkdown(number int, pullRequests []ghClient.ClosingPullRequest) string {
+	md := fmt.Sprintf("# Pull Requests Closing Issue #%d\n\n", number)
+
+	if len(pullRequests) == 0 {
+		md += "No pull requests will close this issue.\n"
+		return md
+	}
+
+	md += "| Pull Request | Title | State | Author |\n"
+	md += "|--------------|-------|-------|--------|\n"
+	for _, pr := range pullRequests {
+		state := strings.ToLower(pr.State)
+		if pr.IsDraft && pr.State == "OPEN" {
+			state = "draft"
+		}
+		md += fmt.Sprintf("| [%s#%d](%s) | %s | %s | %s |\n",
+			pr.Repository.NameWithOwner, pr.Number, pr.URL, escapeTableCell(pr.Title), state, pr.Author.Login)
+	}
+	md += "\n"
+
+	return md
+}
+
+// formatIssueTemplatesToMarkdown converts issue templates and forms to markdown, including
+// what is needed to fill them in with create_issue_from_template
+func formatIssueTemplatesToMarkdown(templates []*ghClient.IssueTemplate) string {
+	md := "# Issue Templates\n\n"
+
+	if len(templates) == 0 {
+		md += fmt.Sprintf("No issue templates found in %s.\n", ghClient.IssueTemplateDir)
+		return md
+	}
+
+	md += fmt.Sprintf("Found %d templates.\n\n", len(templates))
+
+	for _, template := range templates {
+		md += fmt.Sprintf("## %s\n\n", template.Name)
+		md += fmt.Sprintf("**File:** %s  \n", template.Path)
+		if template.Error != "" {
+			md += fmt.Sprintf("**Error:** %s  \n\n", template.Error)
+			continue
+		}
+		md += fmt.Sprintf("**Kind:** %s  \n", template.Kind)
+		if template.Description != "" {
+			md += fmt.Sprintf("**Description:** %s  \n", template.Description)
+		}
+		if template.Title != "" {
+			md += fmt.Sprintf("**Title prefix:** %q  \n", template.Title)
+		}
+		if len(template.Labels) > 0 {
+			md += fmt.Sprintf("**Labels:** %s  \n", strings.Join(template.Labels, ", "))
+		}
+		if len(template.Assignees) > 0 {
+			md += fmt.Sprintf("**Assignees:** %s  \n", strings.Join(template.Assignees, ", "))
+		}
+		md += "\n"
+
+		if template.Kind == ghClient.IssueTemplateMarkdown {
+			if template.Body != "" {
+				md += fmt.Sprintf("```markdown\n%s\n```\n\n", strings.TrimRight(template.Body, "\n"))
+			}
+			continue
+		}
+
+		md += "| Field | Type | Label | Required | Options / Default |\n"
+		md += "|-------|------|-------|----------|-------------------|\n"
+		for _, field := range template.Fields {
+			if field.Type == ghClient.IssueFormMarkdown {
+				continue
+			}
+			var options []string
+			for j, option := range field.Attributes.Options {
+				label := option.Label
+				if option.Required {
+					label += " (required)"
+				}
+				if field.Attributes.Default != nil && *field.Attributes.Default == j {
+					label += " (default)"
+				}
+				options = append(options, label)
+			}
+			details := strings.Join(options, ", ")
+			if field.Attributes.Multiple {
+				details = "multiple of: " + details
+			}
+			if field.Attributes.Value != "" {
+				details = "default: " + truncateString(field.Attributes.Value, 50)
+			}
+			required := ""
+			if field.Validations.Required {
+				required = "yes"
+			}
+			md += fmt.Sprintf("| %s | %s | %s | %s | %s |\n",
+				field.Key(), field.Type, escapeTableCell(field.Attributes.Label), required, escapeTableCell(details))
+		}
+		md += "\n"
+	}
+
+	return md
+}
+
 // formatIssueListToMarkdown converts a list of GitHub Issues to markdown
 func formatIssueListToMarkdown(issues []*github.Issue) string {
 	md := fmt.Sprintf("# Issues\n\n")
@@ -553,6 +1126,85 @@ func formatIssueCommentToMarkdown(comment *github.IssueComment) string {
 	return md
 }
 
+// formatIssueTimelineToMarkdown converts the timeline events of an issue or pull request to markdown
+func formatIssueTimelineToMarkdown(number int, events []*github.Timeline) string {
+	md := fmt.Sprintf("# Timeline (#%d)\n\n", number)
+
+	if len(events) == 0 {
+		md += "No events found.\n"
+		return md
+	}
+
+	md += fmt.Sprintf("Found %d events.\n\n", len(events))
+
+	for i, event := range events {
+		// Commits have an author instead of an actor, comments and reviews a user
+		who := event.GetActor().GetLogin()
+		if who == "" {
+			who = event.GetUser().GetLogin()
+		}
+		if who == "" {
+			who = event.GetAuthor().GetName()
+		}
+		if who != "" {
+			md += fmt.Sprintf("## %d. %s by %s\n\n", i+1, event.GetEvent(), who)
+		} else {
+			md += fmt.Sprintf("## %d. %s\n\n", i+1, event.GetEvent())
+		}
+
+		if t := ghClient.TimelineEventTime(event); !t.IsZero() {
+			md += fmt.Sprintf("**Date:** %s  \n", t.Format(time.RFC1123))
+		}
+
+		switch event.GetEvent() {
+		case "labeled", "unlabeled":
+			md += fmt.Sprintf("**Label:** %s  \n", event.GetLabel().GetName())
+		case "assigned", "unassigned":
+			md += fmt.Sprintf("**Assignee:** %s  \n", event.GetAssignee().GetLogin())
+		case "milestoned", "demilestoned":
+			md += fmt.Sprintf("**Milestone:** %s  \n", event.GetMilestone().GetTitle())
+		case "renamed":
+			md += fmt.Sprintf("**From:** %s  \n", event.GetRename().GetFrom())
+			md += fmt.Sprintf("**To:** %s  \n", event.GetRename().GetTo())
+		case "cross-referenced":
+			source := event.GetSource().GetIssue()
+			kind := "issue"
+			if source.IsPullRequest() {
+				kind = "pull request"
+			}
+			md += fmt.Sprintf("**Referenced In:** %s #%d %s (%s)  \n", kind, source.GetNumber(), source.GetTitle(), source.GetHTMLURL())
+		case "committed":
+			message, _, _ := strings.Cut(event.GetMessage(), "\n")
+			md += fmt.Sprintf("**Commit:** %s %s  \n", truncateString(event.GetSHA(), 7), message)
+		case "review_requested", "review_request_removed":
+			if event.Reviewer != nil {
+				md += fmt.Sprintf("**Reviewer:** %s  \n", event.GetReviewer().GetLogin())
+			}
+			if event.RequestedTeam != nil {
+				md += fmt.Sprintf("**Team:** %s  \n", event.GetRequestedTeam().GetSlug())
+			}
+		case "reviewed":
+			md += fmt.Sprintf("**State:** %s  \n", event.GetState())
+		}
+
+		// Closing, merging and referencing events may point at a commit
+		if event.GetCommitID() != "" {
+			md += fmt.Sprintf("**Commit:** %s  \n", event.GetCommitID())
+		}
+
+		md += "\n"
+
+		if body := event.GetBody(); body != "" {
+			if len(body) > 200 {
+				body = truncateString(body, 200) + "..."
+			}
+			md += fmt.Sprintf("%s\n\n", body)
+		}
+	}
+
+	return md
+}
+
 // formatIssueCommentListToMarkdown converts a list of GitHub IssueComments to markdown
 func formatIssueCommentListToMarkdown(comments []*github.IssueComment) string {
 	md := fmt.Sprintf("# Issue Comments\n\n")
@@ -880,3 +1532,9 @@ func truncateString(s string, maxLength int) string {
 	// Return truncated string with proper Unicode handling
 	return string(runes[:maxLength])
 }
+
+// escapeTableCell makes s safe to use inside a markdown table cell
+func escapeTableCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.Join(strings.Fields(s), " ")
+}
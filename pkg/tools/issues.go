@@ -2,6 +2,7 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -63,7 +64,8 @@ func RegisterIssueTools(s *Server) {
 		}
 
 		// Format the result as markdown
-		markdown := formatIssueToMarkdown(result)
+		markdown := formatIssueToMarkdown(result.Issue)
+		markdown += formatSubIssuesSummaryToMarkdown(result.SubIssuesSummary)
 		return mcp.NewToolResultText(markdown), nil
 	})
 
@@ -84,6 +86,9 @@ func RegisterIssueTools(s *Server) {
 		mcp.WithString("labels",
 			mcp.Description("Comma-separated list of label names"),
 		),
+		mcp.WithString("milestone",
+			mcp.Description("Milestone title or number, 'none' for issues without a milestone, or '*' for issues with any milestone"),
+		),
 		mcp.WithString("sort",
 			mcp.Description("Sort field (created, updated, comments) - default: created"),
 		),
@@ -93,6 +98,15 @@ func RegisterIssueTools(s *Server) {
 		mcp.WithString("since",
 			mcp.Description("Only issues updated at or after this time (ISO 8601 format)"),
 		),
+		mcp.WithNumber("page",
+			mcp.Description("Fetch only this page (default: fetch pages automatically up to max_items)"),
+		),
+		mcp.WithNumber("per_page",
+			mcp.Description("Number of results per page (max 100, default 30)"),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Maximum number of results to fetch across pages when page is not set (default: 100, max: 1000)"),
+		),
 	)
 
 	s.RegisterTool(listIssuesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -143,8 +157,16 @@ func RegisterIssueTools(s *Server) {
 			}
 		}
 
+		milestone, _ := request.Params.Arguments["milestone"].(string)
+
+		// Parse pagination
+		pagination, paginationErr := parsePaginationOptions(request.Params.Arguments)
+		if paginationErr != nil {
+			return mcp.NewToolResultError(errors.FormatGitHubError(paginationErr)), nil
+		}
+
 		// Call the operation
-		result, err := issueOps.ListIssues(ctx, owner, repo, state, sort, direction, labels, since)
+		result, err := issueOps.ListIssues(ctx, owner, repo, state, sort, direction, labels, since, milestone, pagination)
 		if err != nil {
 			if ghErr, ok := err.(*errors.GitHubError); ok {
 				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
@@ -153,7 +175,8 @@ func RegisterIssueTools(s *Server) {
 		}
 
 		// Format the result as markdown
-		markdown := formatIssueListToMarkdown(result)
+		markdown := formatIssueListToMarkdown(result.Items)
+		markdown += formatTruncationNote(result)
 		return mcp.NewToolResultText(markdown), nil
 	})
 
@@ -272,6 +295,12 @@ func RegisterIssueTools(s *Server) {
 		mcp.WithString("state",
 			mcp.Description("New issue state (open, closed)"),
 		),
+		mcp.WithString("state_reason",
+			mcp.Description("Why the issue is closed or reopened (completed, not_planned, duplicate, reopened); implies the state"),
+		),
+		mcp.WithNumber("duplicate_of",
+			mcp.Description("Number of the original issue in the same repository; closes the issue as its duplicate"),
+		),
 		mcp.WithString("labels",
 			mcp.Description("Comma-separated list of label names"),
 		),
@@ -317,6 +346,13 @@ func RegisterIssueTools(s *Server) {
 			state = stateVal
 		}
 
+		stateReason, _ := request.Params.Arguments["state_reason"].(string)
+
+		duplicateOf := 0
+		if duplicateOfVal, ok := request.Params.Arguments["duplicate_of"].(float64); ok {
+			duplicateOf = int(duplicateOfVal)
+		}
+
 		// Parse labels
 		var labels []string
 		if labelsVal, ok := request.Params.Arguments["labels"].(string); ok && labelsVal != "" {
@@ -344,7 +380,7 @@ func RegisterIssueTools(s *Server) {
 		}
 
 		// Call the operation
-		result, err := issueOps.UpdateIssue(ctx, owner, repo, number, title, body, state, labels, assignees, milestone)
+		result, err := issueOps.UpdateIssue(ctx, owner, repo, number, title, body, state, stateReason, duplicateOf, labels, assignees, milestone)
 		if err != nil {
 			if ghErr, ok := err.(*errors.GitHubError); ok {
 				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
@@ -415,6 +451,178 @@ func RegisterIssueTools(s *Server) {
 		return mcp.NewToolResultText(markdown), nil
 	})
 
+	// Register update_issue_comment tool
+	updateIssueCommentTool := mcp.NewTool("update_issue_comment",
+		mcp.WithDescription("Replace the body of one of your own comments on an issue or pull request"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("comment_id",
+			mcp.Required(),
+			mcp.Description("Comment ID"),
+		),
+		mcp.WithString("body",
+			mcp.Required(),
+			mcp.Description("New comment body"),
+		),
+	)
+
+	s.RegisterTool(updateIssueCommentTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		commentIDFloat, ok := request.Params.Arguments["comment_id"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("comment_id must be a number"))), nil
+		}
+		commentID := int64(commentIDFloat)
+
+		body, ok := request.Params.Arguments["body"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("body must be a string"))), nil
+		}
+
+		// Call the operation
+		result, err := issueOps.UpdateIssueComment(ctx, owner, repo, commentID, body)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error updating comment: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatIssueCommentToMarkdown(result)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register delete_issue_comment tool
+	deleteIssueCommentTool := mcp.NewTool("delete_issue_comment",
+		mcp.WithDescription("Delete one of your own comments on an issue or pull request"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("comment_id",
+			mcp.Required(),
+			mcp.Description("Comment ID"),
+		),
+	)
+
+	s.RegisterTool(deleteIssueCommentTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		commentIDFloat, ok := request.Params.Arguments["comment_id"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("comment_id must be a number"))), nil
+		}
+		commentID := int64(commentIDFloat)
+
+		// Call the operation
+		if err := issueOps.DeleteIssueComment(ctx, owner, repo, commentID); err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error deleting comment: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Comment %d deleted from %s/%s", commentID, owner, repo)), nil
+	})
+
+	// Register upsert_issue_comment tool
+	upsertIssueCommentTool := mcp.NewTool("upsert_issue_comment",
+		mcp.WithDescription("Create or update your own comment on an issue or pull request, identified by a hidden HTML marker. Comments by other users are never matched. Use this for status comments that should be kept up to date instead of posted again."),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Issue or pull request number"),
+		),
+		mcp.WithString("marker",
+			mcp.Required(),
+			mcp.Description("Identifier of the comment, e.g. 'deploy-status'; it is embedded as <!-- marker --> in the body"),
+		),
+		mcp.WithString("body",
+			mcp.Required(),
+			mcp.Description("Comment body"),
+		),
+	)
+
+	s.RegisterTool(upsertIssueCommentTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		marker, ok := request.Params.Arguments["marker"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("marker must be a string"))), nil
+		}
+
+		body, ok := request.Params.Arguments["body"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("body must be a string"))), nil
+		}
+
+		// Call the operation
+		result, err := issueOps.UpsertIssueComment(ctx, owner, repo, number, marker, body)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error upserting comment: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := fmt.Sprintf("Comment %s.\n\n", result.Action)
+		markdown += formatIssueCommentToMarkdown(result.Comment)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
 	// Register list_issue_comments tool
 	listIssueCommentsTool := mcp.NewTool("list_issue_comments",
 		mcp.WithDescription("List comments on an issue in a GitHub repository"),
@@ -439,6 +647,15 @@ func RegisterIssueTools(s *Server) {
 		mcp.WithString("since",
 			mcp.Description("Only comments updated at or after this time (ISO 8601 format)"),
 		),
+		mcp.WithNumber("page",
+			mcp.Description("Fetch only this page (default: fetch pages automatically up to max_items)"),
+		),
+		mcp.WithNumber("per_page",
+			mcp.Description("Number of results per page (max 100, default 30)"),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Maximum number of results to fetch across pages when page is not set (default: 100, max: 1000)"),
+		),
 	)
 
 	s.RegisterTool(listIssueCommentsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -480,8 +697,14 @@ func RegisterIssueTools(s *Server) {
 			since = &t
 		}
 
+		// Parse pagination
+		pagination, paginationErr := parsePaginationOptions(request.Params.Arguments)
+		if paginationErr != nil {
+			return mcp.NewToolResultError(errors.FormatGitHubError(paginationErr)), nil
+		}
+
 		// Call the operation
-		result, err := issueOps.ListIssueComments(ctx, owner, repo, number, sort, direction, since)
+		result, err := issueOps.ListIssueComments(ctx, owner, repo, number, sort, direction, since, pagination)
 		if err != nil {
 			if ghErr, ok := err.(*errors.GitHubError); ok {
 				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
@@ -490,8 +713,631 @@ func RegisterIssueTools(s *Server) {
 		}
 
 		// Format the result as markdown
-		markdown := formatIssueCommentListToMarkdown(result)
+		markdown := formatIssueCommentListToMarkdown(result.Items)
+		markdown += formatTruncationNote(result)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register get_issue_timeline tool
+	getIssueTimelineTool := mcp.NewTool("get_issue_timeline",
+		mcp.WithDescription("Get the chronological timeline of an issue or pull request: label changes, assignments, cross-references, commits, force-pushes, review requests, renames, closes and comments"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Issue or pull request number"),
+		),
+		mcp.WithString("events",
+			mcp.Description("Comma-separated list of event types to include, e.g. 'labeled,unlabeled,closed,head_ref_force_pushed' (default: all)"),
+		),
+		mcp.WithNumber("page",
+			mcp.Description("Fetch only this page (default: fetch pages automatically up to max_items)"),
+		),
+		mcp.WithNumber("per_page",
+			mcp.Description("Number of results per page (max 100, default 30)"),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Maximum number of results to fetch across pages when page is not set (default: 100, max: 1000)"),
+		),
+	)
+
+	s.RegisterTool(getIssueTimelineTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		var events []string
+		if eventsVal, ok := request.Params.Arguments["events"].(string); ok {
+			events = splitCommaList(eventsVal)
+		}
+
+		// Parse pagination
+		pagination, paginationErr := parsePaginationOptions(request.Params.Arguments)
+		if paginationErr != nil {
+			return mcp.NewToolResultError(errors.FormatGitHubError(paginationErr)), nil
+		}
+
+		// Call the operation
+		result, err := issueOps.ListIssueTimeline(ctx, owner, repo, number, events, pagination)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting timeline: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatIssueTimelineToMarkdown(number, result.Items)
+		markdown += formatTruncationNote(result)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register get_issue_hierarchy tool
+	getIssueHierarchyTool := mcp.NewTool("get_issue_hierarchy",
+		mcp.WithDescription("Get the parent and the sub-issues of an issue, with completion rollups"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Issue number"),
+		),
+	)
+
+	s.RegisterTool(getIssueHierarchyTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		// Call the operation
+		hierarchy, err := issueOps.GetIssueHierarchy(ctx, owner, repo, number)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting issue hierarchy: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatIssueHierarchyToMarkdown(hierarchy)
 		return mcp.NewToolResultText(markdown), nil
 	})
 
+	// Register add_sub_issue tool
+	addSubIssueTool := mcp.NewTool("add_sub_issue",
+		mcp.WithDescription("Make an issue a sub-issue of another issue; the sub-issue may be in another repository"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner of the parent issue (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name of the parent issue"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Parent issue number"),
+		),
+		mcp.WithNumber("sub_issue_number",
+			mcp.Required(),
+			mcp.Description("Number of the issue to add as a sub-issue"),
+		),
+		mcp.WithString("sub_issue_owner",
+			mcp.Description("Repository owner of the sub-issue (default: owner)"),
+		),
+		mcp.WithString("sub_issue_repo",
+			mcp.Description("Repository name of the sub-issue (default: repo)"),
+		),
+		mcp.WithBoolean("replace_parent",
+			mcp.Description("Move the sub-issue if it already has another parent (default: false)"),
+		),
+	)
+
+	s.RegisterTool(addSubIssueTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		subNumberFloat, ok := request.Params.Arguments["sub_issue_number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("sub_issue_number must be a number"))), nil
+		}
+		subNumber := int(subNumberFloat)
+
+		subOwner, _ := request.Params.Arguments["sub_issue_owner"].(string)
+		subRepo, _ := request.Params.Arguments["sub_issue_repo"].(string)
+		replaceParent, _ := request.Params.Arguments["replace_parent"].(bool)
+
+		// Call the operation
+		hierarchy, err := issueOps.AddSubIssue(ctx, owner, repo, number, subOwner, subRepo, subNumber, replaceParent)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error adding sub-issue: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatIssueHierarchyToMarkdown(hierarchy)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register remove_sub_issue tool
+	removeSubIssueTool := mcp.NewTool("remove_sub_issue",
+		mcp.WithDescription("Remove a sub-issue from its parent issue; both issues are kept"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner of the parent issue (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name of the parent issue"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Parent issue number"),
+		),
+		mcp.WithNumber("sub_issue_number",
+			mcp.Required(),
+			mcp.Description("Number of the sub-issue to remove"),
+		),
+		mcp.WithString("sub_issue_owner",
+			mcp.Description("Repository owner of the sub-issue (default: owner)"),
+		),
+		mcp.WithString("sub_issue_repo",
+			mcp.Description("Repository name of the sub-issue (default: repo)"),
+		),
+	)
+
+	s.RegisterTool(removeSubIssueTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		subNumberFloat, ok := request.Params.Arguments["sub_issue_number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("sub_issue_number must be a number"))), nil
+		}
+		subNumber := int(subNumberFloat)
+
+		subOwner, _ := request.Params.Arguments["sub_issue_owner"].(string)
+		subRepo, _ := request.Params.Arguments["sub_issue_repo"].(string)
+
+		// Call the operation
+		hierarchy, err := issueOps.RemoveSubIssue(ctx, owner, repo, number, subOwner, subRepo, subNumber)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error removing sub-issue: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatIssueHierarchyToMarkdown(hierarchy)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register transfer_issue tool
+	transferIssueTool := mcp.NewTool("transfer_issue",
+		mcp.WithDescription("Move an issue to another repository of the same owner; comments, assignees and sub-issues move with it"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Issue number"),
+		),
+		mcp.WithString("target_repo",
+			mcp.Required(),
+			mcp.Description("Name of the repository to move the issue to"),
+		),
+		mcp.WithString("target_owner",
+			mcp.Description("Owner of the target repository (default: owner)"),
+		),
+		mcp.WithBoolean("create_labels",
+			mcp.Description("Create labels that do not exist in the target repository instead of dropping them (default: false)"),
+		),
+	)
+
+	s.RegisterTool(transferIssueTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		targetRepo, ok := request.Params.Arguments["target_repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("target_repo must be a string"))), nil
+		}
+
+		targetOwner, _ := request.Params.Arguments["target_owner"].(string)
+		createLabels, _ := request.Params.Arguments["create_labels"].(bool)
+
+		// Call the operation
+		issue, err := issueOps.TransferIssue(ctx, owner, repo, number, targetOwner, targetRepo, createLabels)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error transferring issue: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Transferred %s/%s#%d to %s#%d: %s", owner, repo, number, issue.Repository.NameWithOwner, issue.Number, issue.URL)), nil
+	})
+
+	// Register list_closing_pull_requests tool
+	listClosingPullRequestsTool := mcp.NewTool("list_closing_pull_requests",
+		mcp.WithDescription("List the pull requests that will close an issue when merged, through a closing keyword or a manual link"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Issue number"),
+		),
+		mcp.WithBoolean("include_closed",
+			mcp.Description("Also list closed pull requests that were not merged (default: false)"),
+		),
+	)
+
+	s.RegisterTool(listClosingPullRequestsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		includeClosed, _ := request.Params.Arguments["include_closed"].(bool)
+
+		// Call the operation
+		pullRequests, err := issueOps.ListClosingPullRequests(ctx, owner, repo, number, includeClosed)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error listing closing pull requests: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatClosingPullRequestsToMarkdown(number, pullRequests)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register list_issue_templates tool
+	listIssueTemplatesTool := mcp.NewTool("list_issue_templates",
+		mcp.WithDescription("List the issue templates and issue forms of a repository (.github/ISSUE_TEMPLATE), with their fields, default labels and assignees"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithString("ref",
+			mcp.Description("Branch, tag or commit to read the templates from (default: the default branch)"),
+		),
+	)
+
+	s.RegisterTool(listIssueTemplatesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		ref, _ := request.Params.Arguments["ref"].(string)
+
+		// Call the operation
+		templates, err := issueOps.ListIssueTemplates(ctx, owner, repo, ref)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error listing issue templates: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatIssueTemplatesToMarkdown(templates)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register create_issue_from_template tool
+	createIssueFromTemplateTool := mcp.NewTool("create_issue_from_template",
+		mcp.WithDescription("Create an issue from an issue template or issue form. Form fields are validated against the form (required fields, dropdown options) and rendered as GitHub does; the template's title prefix, labels and assignees are applied."),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithString("template",
+			mcp.Required(),
+			mcp.Description("Template file name (e.g. bug_report.yml) or template name, as listed by list_issue_templates"),
+		),
+		mcp.WithString("title",
+			mcp.Required(),
+			mcp.Description("Issue title; the template's title prefix is added if missing"),
+		),
+		mcp.WithString("fields",
+			mcp.Description("For issue forms: JSON object of field values keyed by field ID, e.g. {\"version\": \"1.2\", \"os\": [\"Linux\"], \"terms\": [\"I agree\"]}. Dropdowns take an option or a list of options, checkboxes the list of checked options."),
+		),
+		mcp.WithString("body",
+			mcp.Description("For markdown templates: the filled-in body (default: the template text)"),
+		),
+		mcp.WithString("labels",
+			mcp.Description("Comma-separated list of labels to add to the template's labels"),
+		),
+		mcp.WithString("assignees",
+			mcp.Description("Comma-separated list of usernames to assign in addition to the template's assignees"),
+		),
+	)
+
+	s.RegisterTool(createIssueFromTemplateTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		var input github.IssueFromTemplate
+		input.Template, ok = request.Params.Arguments["template"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("template must be a string"))), nil
+		}
+
+		input.Title, ok = request.Params.Arguments["title"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("title must be a string"))), nil
+		}
+
+		input.Body, _ = request.Params.Arguments["body"].(string)
+
+		// Parse the JSON string
+		if fieldsVal, ok := request.Params.Arguments["fields"]; ok {
+			fieldsStr, ok := fieldsVal.(string)
+			if !ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("fields must be a string containing a JSON object"))), nil
+			}
+			if fieldsStr != "" {
+				if err := json.Unmarshal([]byte(fieldsStr), &input.Fields); err != nil {
+					return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("fields must be a valid JSON object: " + err.Error()))), nil
+				}
+			}
+		}
+
+		if labelsVal, ok := request.Params.Arguments["labels"].(string); ok {
+			input.Labels = splitCommaList(labelsVal)
+		}
+		if assigneesVal, ok := request.Params.Arguments["assignees"].(string); ok {
+			input.Assignees = splitCommaList(assigneesVal)
+		}
+
+		// Call the operation
+		result, err := issueOps.CreateIssueFromTemplate(ctx, owner, repo, input)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error creating issue from template: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatIssueToMarkdown(result)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	lockReasonDescription := fmt.Sprintf("Optional reason shown on the issue (%s)", strings.Join(github.LockReasons, ", "))
+
+	// Register lock_issue tool
+	lockIssueTool := mcp.NewTool("lock_issue",
+		mcp.WithDescription("Lock the conversation of an issue or pull request so only collaborators can comment"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Issue or pull request number"),
+		),
+		mcp.WithString("lock_reason",
+			mcp.Description(lockReasonDescription),
+		),
+	)
+
+	s.RegisterTool(lockIssueTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		lockReason, _ := request.Params.Arguments["lock_reason"].(string)
+
+		// Call the operation
+		err := issueOps.LockIssue(ctx, owner, repo, number, lockReason)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error locking issue: %v", err)), nil
+		}
+
+		message := fmt.Sprintf("Locked the conversation of #%d in %s/%s", number, owner, repo)
+		if lockReason != "" {
+			message += fmt.Sprintf(" as %s", lockReason)
+		}
+		return mcp.NewToolResultText(message), nil
+	})
+
+	// Register unlock_issue tool
+	unlockIssueTool := mcp.NewTool("unlock_issue",
+		mcp.WithDescription("Unlock the conversation of an issue or pull request"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Issue or pull request number"),
+		),
+	)
+
+	s.RegisterTool(unlockIssueTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		// Call the operation
+		err := issueOps.UnlockIssue(ctx, owner, repo, number)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error unlocking issue: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Unlocked the conversation of #%d in %s/%s", number, owner, repo)), nil
+	})
+
 }
@@ -99,6 +99,15 @@ func TestIssues(t *testing.T) {
 				"labels": "bug,enhancement",
 			},
 		},
+		{
+			Name: "ListIssuesByMilestoneTitle",
+			Tool: "list_issues",
+			Input: map[string]interface{}{
+				"owner":     ISSUE_OWNER,
+				"repo":      ISSUE_REPO,
+				"milestone": "V1.0",
+			},
+		},
 
 		// list_issues - Error Cases
 		{
@@ -126,6 +135,25 @@ func TestIssues(t *testing.T) {
 				"state": "invalid-state",
 			},
 		},
+		{
+			Name: "ListIssuesInvalidMaxItems",
+			Tool: "list_issues",
+			Input: map[string]interface{}{
+				"owner":     ISSUE_OWNER,
+				"repo":      ISSUE_REPO,
+				"max_items": 5000,
+			},
+		},
+		{
+			Name: "ListIssuesPageWithMaxItems",
+			Tool: "list_issues",
+			Input: map[string]interface{}{
+				"owner":     ISSUE_OWNER,
+				"repo":      ISSUE_REPO,
+				"page":      2,
+				"max_items": 50,
+			},
+		},
 
 		// create_issue - Happy Path
 		{
@@ -352,6 +380,396 @@ func TestIssues(t *testing.T) {
 				"number": 9999, // Non-existent issue
 			},
 		},
+
+		// get_issue_timeline - Happy Path
+		{
+			Name: "GetTimeline",
+			Tool: "get_issue_timeline",
+			Input: map[string]interface{}{
+				"owner":  ISSUE_OWNER,
+				"repo":   ISSUE_REPO,
+				"number": 1,
+			},
+		},
+
+		// get_issue_timeline - Validation
+		{
+			Name: "GetTimelineInvalidNumber",
+			Tool: "get_issue_timeline",
+			Input: map[string]interface{}{
+				"owner":  ISSUE_OWNER,
+				"repo":   ISSUE_REPO,
+				"number": -3,
+			},
+		},
+		{
+			Name: "GetTimelineInvalidPagination",
+			Tool: "get_issue_timeline",
+			Input: map[string]interface{}{
+				"owner":     ISSUE_OWNER,
+				"repo":      ISSUE_REPO,
+				"number":    1,
+				"page":      2,
+				"max_items": 50,
+			},
+		},
+
+		// update_issue_comment - Happy Path
+		{
+			Name: "UpdateComment",
+			Tool: "update_issue_comment",
+			Input: map[string]interface{}{
+				"owner":      OWNER,
+				"repo":       REPO,
+				"comment_id": 2710335931,
+				"body":       "Updated comment body",
+			},
+		},
+
+		// update_issue_comment - Validation
+		{
+			Name: "UpdateCommentEmptyBody",
+			Tool: "update_issue_comment",
+			Input: map[string]interface{}{
+				"owner":      OWNER,
+				"repo":       REPO,
+				"comment_id": 1,
+				"body":       "",
+			},
+		},
+		{
+			Name: "UpdateCommentByOtherUser",
+			Tool: "update_issue_comment",
+			Input: map[string]interface{}{
+				"owner":      OWNER,
+				"repo":       REPO,
+				"comment_id": 2710336120,
+				"body":       "Updated comment body",
+			},
+		},
+
+		// delete_issue_comment - Happy Path
+		{
+			Name: "DeleteComment",
+			Tool: "delete_issue_comment",
+			Input: map[string]interface{}{
+				"owner":      OWNER,
+				"repo":       REPO,
+				"comment_id": 2710335931,
+			},
+		},
+
+		// delete_issue_comment - Validation
+		{
+			Name: "DeleteCommentInvalidID",
+			Tool: "delete_issue_comment",
+			Input: map[string]interface{}{
+				"owner":      OWNER,
+				"repo":       REPO,
+				"comment_id": 0,
+			},
+		},
+
+		// upsert_issue_comment - Happy Path
+		{
+			Name: "UpsertCommentUpdatesExisting",
+			Tool: "upsert_issue_comment",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 1,
+				"marker": "ci-status",
+				"body":   "Status: green",
+			},
+		},
+
+		// upsert_issue_comment - Validation
+		{
+			Name: "UpsertCommentEmptyMarker",
+			Tool: "upsert_issue_comment",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 1,
+				"marker": " ",
+				"body":   "Status: green",
+			},
+		},
+		{
+			Name: "UpsertCommentInvalidMarker",
+			Tool: "upsert_issue_comment",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 1,
+				"marker": "status -->",
+				"body":   "Status: green",
+			},
+		},
+
+		// get_issue_hierarchy - Happy Path
+		{
+			Name: "GetHierarchy",
+			Tool: "get_issue_hierarchy",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 1,
+			},
+		},
+
+		// get_issue_hierarchy - Validation
+		{
+			Name: "GetHierarchyInvalidNumber",
+			Tool: "get_issue_hierarchy",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 0,
+			},
+		},
+
+		// add_sub_issue - Happy Path
+		{
+			Name: "AddSubIssue",
+			Tool: "add_sub_issue",
+			Input: map[string]interface{}{
+				"owner":            OWNER,
+				"repo":             REPO,
+				"number":           1,
+				"sub_issue_number": 15,
+			},
+		},
+
+		// add_sub_issue - Validation
+		{
+			Name: "AddSubIssueToItself",
+			Tool: "add_sub_issue",
+			Input: map[string]interface{}{
+				"owner":            OWNER,
+				"repo":             REPO,
+				"number":           1,
+				"sub_issue_number": 1,
+			},
+		},
+
+		// remove_sub_issue - Happy Path
+		{
+			Name: "RemoveSubIssue",
+			Tool: "remove_sub_issue",
+			Input: map[string]interface{}{
+				"owner":            OWNER,
+				"repo":             REPO,
+				"number":           1,
+				"sub_issue_number": 15,
+			},
+		},
+
+		// remove_sub_issue - Validation
+		{
+			Name: "RemoveSubIssueMissingNumber",
+			Tool: "remove_sub_issue",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 1,
+			},
+		},
+
+		// transfer_issue - Happy Path
+		{
+			Name: "TransferIssue",
+			Tool: "transfer_issue",
+			Input: map[string]interface{}{
+				"owner":         OWNER,
+				"repo":          REPO,
+				"number":        15,
+				"target_repo":   "github-mcp-go-test-archive",
+				"create_labels": true,
+			},
+		},
+
+		// transfer_issue - Validation
+		{
+			Name: "TransferIssueToSameRepo",
+			Tool: "transfer_issue",
+			Input: map[string]interface{}{
+				"owner":       OWNER,
+				"repo":        REPO,
+				"number":      1,
+				"target_repo": REPO,
+			},
+		},
+
+		// list_closing_pull_requests - Happy Path
+		{
+			Name: "ListClosingPullRequests",
+			Tool: "list_closing_pull_requests",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 14,
+			},
+		},
+
+		// list_closing_pull_requests - Validation
+		{
+			Name: "ListClosingPullRequestsInvalidNumber",
+			Tool: "list_closing_pull_requests",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": -1,
+			},
+		},
+
+		// list_issue_templates - Happy Path
+		{
+			Name: "ListTemplates",
+			Tool: "list_issue_templates",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+			},
+		},
+
+		// list_issue_templates - Validation
+		{
+			Name: "ListTemplatesEmptyOwner",
+			Tool: "list_issue_templates",
+			Input: map[string]interface{}{
+				"owner": "",
+				"repo":  REPO,
+			},
+		},
+
+		// create_issue_from_template - Happy Path
+		{
+			Name: "CreateFromIssueForm",
+			Tool: "create_issue_from_template",
+			Input: map[string]interface{}{
+				"owner":    OWNER,
+				"repo":     REPO,
+				"template": "bug_report.yml",
+				"title":    "Crash on start",
+				"fields":   `{"version": "1.2.0", "os": "Linux", "what-happened": "The server exits right after start."}`,
+			},
+		},
+
+		// create_issue_from_template - Validation
+		{
+			Name: "CreateFromTemplateInvalidFields",
+			Tool: "create_issue_from_template",
+			Input: map[string]interface{}{
+				"owner":    OWNER,
+				"repo":     REPO,
+				"template": "bug_report.yml",
+				"title":    "Crash on start",
+				"fields":   "version: 1.0",
+			},
+		},
+		{
+			Name: "CreateFromTemplateEmptyTemplate",
+			Tool: "create_issue_from_template",
+			Input: map[string]interface{}{
+				"owner":    OWNER,
+				"repo":     REPO,
+				"template": "",
+				"title":    "Crash on start",
+			},
+		},
+
+		// update_issue - State reason
+		{
+			Name: "UpdateIssueCloseNotPlanned",
+			Tool: "update_issue",
+			Input: map[string]interface{}{
+				"owner":        OWNER,
+				"repo":         REPO,
+				"number":       18,
+				"state_reason": "not_planned",
+			},
+		},
+		{
+			Name: "UpdateIssueCloseAsDuplicate",
+			Tool: "update_issue",
+			Input: map[string]interface{}{
+				"owner":        OWNER,
+				"repo":         REPO,
+				"number":       18,
+				"title":        "Crash when the token expires (duplicate)",
+				"duplicate_of": 14,
+			},
+		},
+
+		// update_issue - State reason validation
+		{
+			Name: "UpdateIssueStateReasonConflict",
+			Tool: "update_issue",
+			Input: map[string]interface{}{
+				"owner":        OWNER,
+				"repo":         REPO,
+				"number":       1,
+				"state":        "open",
+				"state_reason": "not_planned",
+			},
+		},
+		{
+			Name: "UpdateIssueDuplicateWithoutOriginal",
+			Tool: "update_issue",
+			Input: map[string]interface{}{
+				"owner":        OWNER,
+				"repo":         REPO,
+				"number":       1,
+				"state_reason": "duplicate",
+			},
+		},
+
+		// lock_issue - Happy Path
+		{
+			Name: "LockIssue",
+			Tool: "lock_issue",
+			Input: map[string]interface{}{
+				"owner":       OWNER,
+				"repo":        REPO,
+				"number":      18,
+				"lock_reason": "resolved",
+			},
+		},
+
+		// lock_issue - Validation
+		{
+			Name: "LockIssueInvalidReason",
+			Tool: "lock_issue",
+			Input: map[string]interface{}{
+				"owner":       OWNER,
+				"repo":        REPO,
+				"number":      1,
+				"lock_reason": "boring",
+			},
+		},
+
+		// unlock_issue - Happy Path
+		{
+			Name: "UnlockIssue",
+			Tool: "unlock_issue",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 18,
+			},
+		},
+
+		// unlock_issue - Validation
+		{
+			Name: "UnlockIssueInvalidNumber",
+			Tool: "unlock_issue",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 0,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
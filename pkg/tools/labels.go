@@ -0,0 +1,435 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gh "github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+	"github.com/geropl/github-mcp-go/pkg/github"
+)
+
+// RegisterLabelTools registers label-related tools
+func RegisterLabelTools(s *Server) {
+	client := s.GetClient()
+	logger := s.GetLogger()
+	labelOps := github.NewLabelOperations(client, logger)
+
+	// Register list_labels tool
+	listLabelsTool := mcp.NewTool("list_labels",
+		mcp.WithDescription("List the labels of a GitHub repository"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("page",
+			mcp.Description("Fetch only this page (default: fetch pages automatically up to max_items)"),
+		),
+		mcp.WithNumber("per_page",
+			mcp.Description("Number of results per page (max 100, default 30)"),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Maximum number of results to fetch across pages when page is not set (default: 100, max: 1000)"),
+		),
+	)
+
+	s.RegisterTool(listLabelsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		// Parse pagination
+		pagination, paginationErr := parsePaginationOptions(request.Params.Arguments)
+		if paginationErr != nil {
+			return mcp.NewToolResultError(errors.FormatGitHubError(paginationErr)), nil
+		}
+
+		// Call the operation
+		result, err := labelOps.ListLabels(ctx, owner, repo, pagination)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error listing labels: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatLabelListToMarkdown(result.Items)
+		markdown += formatTruncationNote(result)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register create_label tool
+	createLabelTool := mcp.NewTool("create_label",
+		mcp.WithDescription("Create a label in a GitHub repository"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Label name"),
+		),
+		mcp.WithString("color",
+			mcp.Required(),
+			mcp.Description("Label color as a 6-digit hex code, e.g. d73a4a"),
+		),
+		mcp.WithString("description",
+			mcp.Description("Label description"),
+		),
+	)
+
+	s.RegisterTool(createLabelTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		name, ok := request.Params.Arguments["name"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("name must be a string"))), nil
+		}
+
+		color, ok := request.Params.Arguments["color"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("color must be a string"))), nil
+		}
+
+		description, _ := request.Params.Arguments["description"].(string)
+
+		// Call the operation
+		label, err := labelOps.CreateLabel(ctx, owner, repo, name, color, description)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error creating label: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatLabelToMarkdown(label)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register update_label tool
+	updateLabelTool := mcp.NewTool("update_label",
+		mcp.WithDescription("Rename a label or change its color or description; renaming keeps it on all issues and pull requests"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Current label name"),
+		),
+		mcp.WithString("new_name",
+			mcp.Description("New label name"),
+		),
+		mcp.WithString("color",
+			mcp.Description("New color as a 6-digit hex code, e.g. d73a4a"),
+		),
+		mcp.WithString("description",
+			mcp.Description("New description"),
+		),
+	)
+
+	s.RegisterTool(updateLabelTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		name, ok := request.Params.Arguments["name"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("name must be a string"))), nil
+		}
+
+		var update github.LabelUpdate
+		if newNameVal, ok := request.Params.Arguments["new_name"].(string); ok {
+			update.NewName = &newNameVal
+		}
+		if colorVal, ok := request.Params.Arguments["color"].(string); ok {
+			update.Color = &colorVal
+		}
+		if descriptionVal, ok := request.Params.Arguments["description"].(string); ok {
+			update.Description = &descriptionVal
+		}
+
+		// Call the operation
+		label, err := labelOps.UpdateLabel(ctx, owner, repo, name, update)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error updating label: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatLabelToMarkdown(label)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register delete_label tool
+	deleteLabelTool := mcp.NewTool("delete_label",
+		mcp.WithDescription("Delete a label from a GitHub repository, removing it from all issues and pull requests"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Label name"),
+		),
+	)
+
+	s.RegisterTool(deleteLabelTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		name, ok := request.Params.Arguments["name"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("name must be a string"))), nil
+		}
+
+		// Call the operation
+		if err := labelOps.DeleteLabel(ctx, owner, repo, name); err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error deleting label: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Label %q deleted from %s/%s", name, owner, repo)), nil
+	})
+
+	// Register sync_labels tool
+	syncLabelsTool := mcp.NewTool("sync_labels",
+		mcp.WithDescription("Reconcile the labels of a repository with a YAML or JSON labels file stored in a repository. "+
+			"The file is a list of {name, color, description, aliases} entries or an object with a 'labels' list; "+
+			"existing labels named like an alias are renamed. Runs as a dry run unless dry_run is false"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Owner of the repository whose labels are synced"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Name of the repository whose labels are synced"),
+		),
+		mcp.WithString("path",
+			mcp.Required(),
+			mcp.Description("Path of the labels file, e.g. .github/labels.yml"),
+		),
+		mcp.WithString("source_owner",
+			mcp.Description("Owner of the repository containing the labels file (default: owner)"),
+		),
+		mcp.WithString("source_repo",
+			mcp.Description("Repository containing the labels file (default: repo)"),
+		),
+		mcp.WithString("ref",
+			mcp.Description("Branch, tag or commit of the labels file (default: the default branch)"),
+		),
+		mcp.WithBoolean("prune",
+			mcp.Description("Delete labels that are not defined in the file (default: false)"),
+		),
+		mcp.WithBoolean("dry_run",
+			mcp.Description("Only show the changes without applying them (default: true)"),
+		),
+	)
+
+	s.RegisterTool(syncLabelsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		path, ok := request.Params.Arguments["path"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("path must be a string"))), nil
+		}
+
+		source := github.LabelSyncSource{Path: path}
+		source.Owner, _ = request.Params.Arguments["source_owner"].(string)
+		source.Repo, _ = request.Params.Arguments["source_repo"].(string)
+		source.Ref, _ = request.Params.Arguments["ref"].(string)
+
+		// Optional parameters with defaults
+		prune := false
+		if pruneVal, ok := request.Params.Arguments["prune"].(bool); ok {
+			prune = pruneVal
+		}
+		dryRun := true
+		if dryRunVal, ok := request.Params.Arguments["dry_run"].(bool); ok {
+			dryRun = dryRunVal
+		}
+
+		// Call the operation
+		result, err := labelOps.SyncLabels(ctx, owner, repo, source, prune, dryRun)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error syncing labels: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatLabelSyncResultToMarkdown(owner, repo, result)
+		return mcp.NewToolResultText(markdown), nil
+	})
+}
+
+// formatLabelToMarkdown converts a label to markdown
+func formatLabelToMarkdown(label *gh.Label) string {
+	md := fmt.Sprintf("# Label: %s\n\n", label.GetName())
+	md += fmt.Sprintf("**Color:** #%s  \n", label.GetColor())
+	if label.GetDescription() != "" {
+		md += fmt.Sprintf("**Description:** %s  \n", label.GetDescription())
+	}
+	md += fmt.Sprintf("**URL:** %s  \n", label.GetURL())
+	return md
+}
+
+// formatLabelListToMarkdown converts a list of labels to a markdown table
+func formatLabelListToMarkdown(labels []*gh.Label) string {
+	md := "# Labels\n\n"
+
+	if len(labels) == 0 {
+		md += "No labels found.\n"
+		return md
+	}
+
+	md += fmt.Sprintf("Found %d labels.\n\n", len(labels))
+
+	md += "| Name | Color | Description |\n"
+	md += "|------|-------|-------------|\n"
+	for _, label := range labels {
+		md += fmt.Sprintf("| %s | #%s | %s |\n",
+			escapeTableCell(label.GetName()),
+			label.GetColor(),
+			escapeTableCell(label.GetDescription()),
+		)
+	}
+	md += "\n"
+
+	return md
+}
+
+// formatLabelSyncResultToMarkdown converts the result of a label sync to a markdown diff
+func formatLabelSyncResultToMarkdown(owner, repo string, result *github.LabelSyncResult) string {
+	md := fmt.Sprintf("# Label Sync: %s/%s\n\n", owner, repo)
+
+	failed := 0
+	for _, change := range result.Changes {
+		if change.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		md += fmt.Sprintf("**Warning:** %d of %d changes failed. See the Result column for details.\n\n", failed, len(result.Changes))
+	}
+
+	if result.DryRun {
+		md += "**Dry run:** no changes were applied. Run again with dry_run=false to apply them.\n\n"
+	}
+
+	if len(result.Changes) == 0 {
+		md += "Labels are already in sync.\n\n"
+	} else {
+		md += "| Action | Label | Color | Description | Result |\n"
+		md += "|--------|-------|-------|-------------|--------|\n"
+		for _, change := range result.Changes {
+			name := change.Name
+			if change.NewName != "" {
+				name = fmt.Sprintf("%s → %s", change.Name, change.NewName)
+			}
+
+			color, description := "#"+change.Color, change.Description
+			switch change.Action {
+			case github.LabelActionUpdate:
+				if change.OldColor != change.Color {
+					color = fmt.Sprintf("#%s → #%s", change.OldColor, change.Color)
+				}
+				if change.OldDescription != change.Description {
+					description = fmt.Sprintf("%q → %q", change.OldDescription, change.Description)
+				}
+			case github.LabelActionDelete:
+				color, description = "#"+change.OldColor, change.OldDescription
+			}
+
+			status := "planned"
+			if !result.DryRun {
+				status = "done"
+			}
+			if change.Error != "" {
+				status = "failed: " + change.Error
+			}
+
+			md += fmt.Sprintf("| %s | %s | %s | %s | %s |\n",
+				change.Action,
+				escapeTableCell(name),
+				color,
+				escapeTableCell(description),
+				escapeTableCell(status),
+			)
+		}
+		md += "\n"
+	}
+
+	md += fmt.Sprintf("**Unchanged:** %d  \n", result.Unchanged)
+	if len(result.Unmanaged) > 0 {
+		md += fmt.Sprintf("**Not in the labels file (kept, use prune=true to delete):** %s  \n", strings.Join(result.Unmanaged, ", "))
+	}
+
+	return md
+}
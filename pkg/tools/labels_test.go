@@ -0,0 +1,138 @@
+package tools
+
+import (
+	"testing"
+)
+
+func TestLabels(t *testing.T) {
+	testCases := []*TestCase{
+		// list_labels - Happy Path
+		{
+			Name: "ListLabels",
+			Tool: "list_labels",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+			},
+		},
+
+		// create_label - Happy Path
+		{
+			Name: "CreateLabel",
+			Tool: "create_label",
+			Input: map[string]interface{}{
+				"owner":       OWNER,
+				"repo":        REPO,
+				"name":        "needs-triage",
+				"color":       "#FBCA04",
+				"description": "Waiting for a maintainer to look at it",
+			},
+		},
+
+		// create_label - Validation
+		{
+			Name: "CreateLabelInvalidColor",
+			Tool: "create_label",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+				"name":  "triage",
+				"color": "orange",
+			},
+		},
+		{
+			Name: "CreateLabelEmptyName",
+			Tool: "create_label",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+				"name":  "",
+				"color": "fbca04",
+			},
+		},
+
+		// update_label - Happy Path
+		{
+			Name: "UpdateLabel",
+			Tool: "update_label",
+			Input: map[string]interface{}{
+				"owner":    OWNER,
+				"repo":     REPO,
+				"name":     "needs-triage",
+				"new_name": "triage",
+				"color":    "e4e669",
+			},
+		},
+
+		// update_label - Validation
+		{
+			Name: "UpdateLabelNoChanges",
+			Tool: "update_label",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+				"name":  "bug",
+			},
+		},
+
+		// delete_label - Happy Path
+		{
+			Name: "DeleteLabel",
+			Tool: "delete_label",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+				"name":  "triage",
+			},
+		},
+
+		// delete_label - Validation
+		{
+			Name: "DeleteLabelMissingName",
+			Tool: "delete_label",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+			},
+		},
+
+		// sync_labels - Happy Path
+		{
+			Name: "SyncLabels",
+			Tool: "sync_labels",
+			Input: map[string]interface{}{
+				"owner":   OWNER,
+				"repo":    REPO,
+				"path":    ".github/labels.yml",
+				"dry_run": false,
+			},
+		},
+		{
+			Name: "SyncLabelsPartialFailure",
+			Tool: "sync_labels",
+			Input: map[string]interface{}{
+				"owner":   OWNER,
+				"repo":    REPO,
+				"path":    ".github/labels.yml",
+				"dry_run": false,
+			},
+		},
+
+		// sync_labels - Validation
+		{
+			Name: "SyncLabelsEmptyPath",
+			Tool: "sync_labels",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+				"path":  "",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			RunTest(t, tc)
+		})
+	}
+}
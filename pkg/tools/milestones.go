@@ -0,0 +1,420 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gh "github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+	"github.com/geropl/github-mcp-go/pkg/github"
+)
+
+// RegisterMilestoneTools registers milestone-related tools
+func RegisterMilestoneTools(s *Server) {
+	client := s.GetClient()
+	logger := s.GetLogger()
+	milestoneOps := github.NewMilestoneOperations(client, logger)
+
+	// Register list_milestones tool
+	listMilestonesTool := mcp.NewTool("list_milestones",
+		mcp.WithDescription("List the milestones of a GitHub repository with their progress and due dates"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithString("state",
+			mcp.Description("Milestone state (open, closed, all) - default: open"),
+		),
+		mcp.WithString("sort",
+			mcp.Description("Sort field (due_on, completeness) - default: due_on"),
+		),
+		mcp.WithString("direction",
+			mcp.Description("Sort direction (asc, desc) - default: asc"),
+		),
+		mcp.WithNumber("page",
+			mcp.Description("Fetch only this page (default: fetch pages automatically up to max_items)"),
+		),
+		mcp.WithNumber("per_page",
+			mcp.Description("Number of results per page (max 100, default 30)"),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Maximum number of results to fetch across pages when page is not set (default: 100, max: 1000)"),
+		),
+	)
+
+	s.RegisterTool(listMilestonesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		// Optional parameters with defaults
+		state := "open"
+		if stateVal, ok := request.Params.Arguments["state"].(string); ok && stateVal != "" {
+			state = stateVal
+		}
+
+		sort := "due_on"
+		if sortVal, ok := request.Params.Arguments["sort"].(string); ok && sortVal != "" {
+			sort = sortVal
+		}
+
+		direction := "asc"
+		if directionVal, ok := request.Params.Arguments["direction"].(string); ok && directionVal != "" {
+			direction = directionVal
+		}
+
+		// Parse pagination
+		pagination, paginationErr := parsePaginationOptions(request.Params.Arguments)
+		if paginationErr != nil {
+			return mcp.NewToolResultError(errors.FormatGitHubError(paginationErr)), nil
+		}
+
+		// Call the operation
+		result, err := milestoneOps.ListMilestones(ctx, owner, repo, state, sort, direction, pagination)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error listing milestones: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatMilestoneListToMarkdown(result.Items)
+		markdown += formatTruncationNote(result)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register get_milestone tool
+	getMilestoneTool := mcp.NewTool("get_milestone",
+		mcp.WithDescription("Get a milestone with its progress and due date"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Milestone number"),
+		),
+	)
+
+	s.RegisterTool(getMilestoneTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		// Call the operation
+		milestone, err := milestoneOps.GetMilestone(ctx, owner, repo, number)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting milestone: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatMilestoneToMarkdown(milestone)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register create_milestone tool
+	createMilestoneTool := mcp.NewTool("create_milestone",
+		mcp.WithDescription("Create a milestone in a GitHub repository"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithString("title",
+			mcp.Required(),
+			mcp.Description("Milestone title"),
+		),
+		mcp.WithString("description",
+			mcp.Description("Milestone description"),
+		),
+		mcp.WithString("due_on",
+			mcp.Description("Due date (YYYY-MM-DD or ISO 8601 format)"),
+		),
+	)
+
+	s.RegisterTool(createMilestoneTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		title, ok := request.Params.Arguments["title"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("title must be a string"))), nil
+		}
+
+		description, _ := request.Params.Arguments["description"].(string)
+
+		var dueOn *time.Time
+		if dueOnVal, ok := request.Params.Arguments["due_on"].(string); ok && dueOnVal != "" {
+			parsed, err := parseDueDate(dueOnVal)
+			if err != nil {
+				return mcp.NewToolResultError(errors.FormatGitHubError(err)), nil
+			}
+			dueOn = &parsed
+		}
+
+		// Call the operation
+		milestone, err := milestoneOps.CreateMilestone(ctx, owner, repo, title, description, dueOn)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error creating milestone: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatMilestoneToMarkdown(milestone)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register update_milestone tool
+	updateMilestoneTool := mcp.NewTool("update_milestone",
+		mcp.WithDescription("Change the title, description, state or due date of a milestone"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Milestone number"),
+		),
+		mcp.WithString("title",
+			mcp.Description("New title"),
+		),
+		mcp.WithString("description",
+			mcp.Description("New description"),
+		),
+		mcp.WithString("state",
+			mcp.Description("New state (open, closed)"),
+		),
+		mcp.WithString("due_on",
+			mcp.Description("New due date (YYYY-MM-DD or ISO 8601 format)"),
+		),
+	)
+
+	s.RegisterTool(updateMilestoneTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		var update github.MilestoneUpdate
+		if titleVal, ok := request.Params.Arguments["title"].(string); ok {
+			update.Title = &titleVal
+		}
+		if descriptionVal, ok := request.Params.Arguments["description"].(string); ok {
+			update.Description = &descriptionVal
+		}
+		if stateVal, ok := request.Params.Arguments["state"].(string); ok {
+			update.State = &stateVal
+		}
+		if dueOnVal, ok := request.Params.Arguments["due_on"].(string); ok && dueOnVal != "" {
+			parsed, err := parseDueDate(dueOnVal)
+			if err != nil {
+				return mcp.NewToolResultError(errors.FormatGitHubError(err)), nil
+			}
+			update.DueOn = &parsed
+		}
+
+		// Call the operation
+		milestone, err := milestoneOps.UpdateMilestone(ctx, owner, repo, number, update)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error updating milestone: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatMilestoneToMarkdown(milestone)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register close_milestone tool
+	closeMilestoneTool := mcp.NewTool("close_milestone",
+		mcp.WithDescription("Close a milestone; its issues keep the milestone"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Milestone number"),
+		),
+	)
+
+	s.RegisterTool(closeMilestoneTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		// Call the operation
+		milestone, err := milestoneOps.UpdateMilestone(ctx, owner, repo, number, github.MilestoneUpdate{State: gh.String("closed")})
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error closing milestone: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatMilestoneToMarkdown(milestone)
+		return mcp.NewToolResultText(markdown), nil
+	})
+}
+
+// parseDueDate parses a due date given as a date or an ISO 8601 timestamp
+func parseDueDate(value string) (time.Time, *errors.GitHubError) {
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+	if parsed, err := time.Parse("2006-01-02", value); err == nil {
+		return parsed, nil
+	}
+	return time.Time{}, errors.NewInvalidArgumentError("due_on must be a date (YYYY-MM-DD) or in ISO 8601 format (YYYY-MM-DDTHH:MM:SSZ)")
+}
+
+// milestoneProgress returns the share of closed issues in a milestone as a percentage
+func milestoneProgress(milestone *gh.Milestone) int {
+	total := milestone.GetOpenIssues() + milestone.GetClosedIssues()
+	if total == 0 {
+		return 0
+	}
+	return milestone.GetClosedIssues() * 100 / total
+}
+
+// formatMilestoneDueDate formats the due date of a milestone, flagging open milestones that are overdue
+func formatMilestoneDueDate(milestone *gh.Milestone) string {
+	if milestone.DueOn == nil {
+		return "none"
+	}
+	due := milestone.GetDueOn().Format("2006-01-02")
+	if milestone.GetState() == "open" && milestone.GetDueOn().Before(time.Now()) {
+		due += " (overdue)"
+	}
+	return due
+}
+
+// formatMilestoneToMarkdown converts a milestone to markdown
+func formatMilestoneToMarkdown(milestone *gh.Milestone) string {
+	md := fmt.Sprintf("# Milestone #%d: %s\n\n", milestone.GetNumber(), milestone.GetTitle())
+	md += fmt.Sprintf("**State:** %s  \n", milestone.GetState())
+	md += fmt.Sprintf("**Due:** %s  \n", formatMilestoneDueDate(milestone))
+	md += fmt.Sprintf("**Progress:** %d%% complete (%d open, %d closed)  \n",
+		milestoneProgress(milestone), milestone.GetOpenIssues(), milestone.GetClosedIssues())
+	if milestone.ClosedAt != nil {
+		md += fmt.Sprintf("**Closed:** %s  \n", milestone.GetClosedAt().Format("2006-01-02 15:04:05"))
+	}
+	md += fmt.Sprintf("**URL:** %s  \n", milestone.GetHTMLURL())
+	if milestone.GetDescription() != "" {
+		md += fmt.Sprintf("\n## Description\n\n%s\n", milestone.GetDescription())
+	}
+	return md
+}
+
+// formatMilestoneListToMarkdown converts a list of milestones to a markdown table
+func formatMilestoneListToMarkdown(milestones []*gh.Milestone) string {
+	md := "# Milestones\n\n"
+
+	if len(milestones) == 0 {
+		md += "No milestones found.\n"
+		return md
+	}
+
+	md += fmt.Sprintf("Found %d milestones.\n\n", len(milestones))
+
+	md += "| # | Title | State | Due | Open | Closed | Progress |\n"
+	md += "|---|-------|-------|-----|------|--------|----------|\n"
+	for _, milestone := range milestones {
+		md += fmt.Sprintf("| %d | %s | %s | %s | %d | %d | %d%% |\n",
+			milestone.GetNumber(),
+			escapeTableCell(milestone.GetTitle()),
+			milestone.GetState(),
+			formatMilestoneDueDate(milestone),
+			milestone.GetOpenIssues(),
+			milestone.GetClosedIssues(),
+			milestoneProgress(milestone),
+		)
+	}
+	md += "\n"
+
+	return md
+}
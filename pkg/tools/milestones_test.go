@@ -0,0 +1,138 @@
+package tools
+
+import (
+	"testing"
+)
+
+func TestMilestones(t *testing.T) {
+	testCases := []*TestCase{
+		// list_milestones - Happy Path
+		{
+			Name: "ListMilestones",
+			Tool: "list_milestones",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+				"state": "all",
+			},
+		},
+
+		// list_milestones - Validation
+		{
+			Name: "ListMilestonesInvalidState",
+			Tool: "list_milestones",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+				"state": "done",
+			},
+		},
+
+		// get_milestone - Happy Path
+		{
+			Name: "GetMilestone",
+			Tool: "get_milestone",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 1,
+			},
+		},
+
+		// get_milestone - Validation
+		{
+			Name: "GetMilestoneInvalidNumber",
+			Tool: "get_milestone",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 0,
+			},
+		},
+
+		// create_milestone - Happy Path
+		{
+			Name: "CreateMilestone",
+			Tool: "create_milestone",
+			Input: map[string]interface{}{
+				"owner":       OWNER,
+				"repo":        REPO,
+				"title":       "v2.0",
+				"description": "Second release",
+				"due_on":      "2025-06-30",
+			},
+		},
+
+		// create_milestone - Validation
+		{
+			Name: "CreateMilestoneInvalidDueDate",
+			Tool: "create_milestone",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"title":  "v1.0",
+				"due_on": "next friday",
+			},
+		},
+
+		// update_milestone - Happy Path
+		{
+			Name: "UpdateMilestone",
+			Tool: "update_milestone",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 3,
+				"title":  "v2.0.0",
+			},
+		},
+
+		// update_milestone - Validation
+		{
+			Name: "UpdateMilestoneNoChanges",
+			Tool: "update_milestone",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 1,
+			},
+		},
+		{
+			Name: "UpdateMilestoneInvalidState",
+			Tool: "update_milestone",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 1,
+				"state":  "done",
+			},
+		},
+
+		// close_milestone - Happy Path
+		{
+			Name: "CloseMilestone",
+			Tool: "close_milestone",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 3,
+			},
+		},
+
+		// close_milestone - Validation
+		{
+			Name: "CloseMilestoneMissingNumber",
+			Tool: "close_milestone",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			RunTest(t, tc)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package tools
+
+import (
+	"fmt"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+	ghclient "github.com/geropl/github-mcp-go/pkg/github"
+)
+
+// parsePaginationOptions extracts the page, per_page and max_items parameters of a list tool
+func parsePaginationOptions(arguments map[string]interface{}) (ghclient.PaginationOptions, *errors.GitHubError) {
+	var opts ghclient.PaginationOptions
+
+	if pageVal, ok := arguments["page"]; ok {
+		page, ok := pageVal.(float64)
+		if !ok || page < 1 {
+			return opts, errors.NewInvalidArgumentError("page must be a number greater than 0")
+		}
+		opts.Page = int(page)
+	}
+
+	if perPageVal, ok := arguments["per_page"]; ok {
+		perPage, ok := perPageVal.(float64)
+		if !ok || perPage < 1 || perPage > ghclient.MaxPerPage {
+			return opts, errors.NewInvalidArgumentError(fmt.Sprintf("per_page must be between 1 and %d", ghclient.MaxPerPage))
+		}
+		opts.PerPage = int(perPage)
+	}
+
+	if maxItemsVal, ok := arguments["max_items"]; ok {
+		maxItems, ok := maxItemsVal.(float64)
+		if !ok || maxItems < 1 || maxItems > ghclient.MaxItemsLimit {
+			return opts, errors.NewInvalidArgumentError(fmt.Sprintf("max_items must be between 1 and %d", ghclient.MaxItemsLimit))
+		}
+		opts.MaxItems = int(maxItems)
+	}
+
+	if opts.Page > 0 && opts.MaxItems > 0 {
+		return opts, errors.NewInvalidArgumentError("page and max_items cannot be combined")
+	}
+
+	return opts, nil
+}
+
+// formatTruncationNote returns a markdown note telling the caller how to get the rest of a truncated list
+func formatTruncationNote[T any](result *ghclient.ListResult[T]) string {
+	if !result.Truncated {
+		return ""
+	}
+
+	if result.ScanLimited {
+		return fmt.Sprintf("**Note:** Stopped after scanning %d pages with fewer than %d matches. Request page %d to continue scanning.\n",
+			ghclient.MaxScanPages, result.MaxItems, result.NextPage)
+	}
+	if result.MaxItems > 0 {
+		continuation := fmt.Sprintf("request page %d", result.NextPage)
+		if result.PerPage > 0 {
+			continuation += fmt.Sprintf(" with per_page %d", result.PerPage)
+		}
+		if result.Offset > 0 {
+			continuation += fmt.Sprintf(" and skip its first %d items", result.Offset)
+		}
+		return fmt.Sprintf("**Note:** Results truncated at %d items. Increase max_items (up to %d) or %s to see more.\n",
+			result.MaxItems, ghclient.MaxItemsLimit, continuation)
+	}
+	if result.NextPage > 0 {
+		return fmt.Sprintf("**Note:** More results are available. Request page %d to see more.\n", result.NextPage)
+	}
+	return "**Note:** More results are available.\n"
+}
@@ -3,6 +3,8 @@ package tools
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 
@@ -151,6 +153,481 @@ func RegisterPullRequestTools(s *Server) {
 		return mcp.NewToolResultText(markdown), nil
 	})
 
+	// Register list_pull_requests tool
+	listPRsTool := mcp.NewTool("list_pull_requests",
+		mcp.WithDescription("List pull requests in a GitHub repository with filtering options"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithString("state",
+			mcp.Description("Pull request state (open, closed, all) - default: open"),
+		),
+		mcp.WithString("base",
+			mcp.Description("Filter by base branch name"),
+		),
+		mcp.WithString("head",
+			mcp.Description("Filter by head branch, as 'user:ref-name' or 'ref-name' for branches in the same repository"),
+		),
+		mcp.WithString("sort",
+			mcp.Description("Sort field (created, updated, popularity, long-running) - default: created"),
+		),
+		mcp.WithString("direction",
+			mcp.Description("Sort direction (asc, desc) - default: desc"),
+		),
+		mcp.WithBoolean("draft",
+			mcp.Description("Only draft (true) or only ready for review (false) pull requests"),
+		),
+		mcp.WithString("author",
+			mcp.Description("Filter by the login of the pull request author"),
+		),
+		mcp.WithString("labels",
+			mcp.Description("Comma-separated list of label names; pull requests must have all of them"),
+		),
+		mcp.WithNumber("page",
+			mcp.Description("Fetch only this page (default: fetch pages automatically up to max_items)"),
+		),
+		mcp.WithNumber("per_page",
+			mcp.Description("Number of results per page (max 100, default 30)"),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Maximum number of results to fetch across pages when page is not set (default: 100, max: 1000)"),
+		),
+	)
+
+	s.RegisterTool(listPRsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		// Optional parameters with defaults
+		filter := github.PullRequestFilter{
+			State:     "open",
+			Sort:      "created",
+			Direction: "desc",
+		}
+		if stateVal, ok := request.Params.Arguments["state"].(string); ok && stateVal != "" {
+			filter.State = stateVal
+		}
+		if sortVal, ok := request.Params.Arguments["sort"].(string); ok && sortVal != "" {
+			filter.Sort = sortVal
+		}
+		if directionVal, ok := request.Params.Arguments["direction"].(string); ok && directionVal != "" {
+			filter.Direction = directionVal
+		}
+		if baseVal, ok := request.Params.Arguments["base"].(string); ok {
+			filter.Base = baseVal
+		}
+		if headVal, ok := request.Params.Arguments["head"].(string); ok {
+			filter.Head = headVal
+		}
+		if authorVal, ok := request.Params.Arguments["author"].(string); ok {
+			filter.Author = authorVal
+		}
+		if draftVal, ok := request.Params.Arguments["draft"]; ok {
+			draft, ok := draftVal.(bool)
+			if !ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("draft must be a boolean"))), nil
+			}
+			filter.Draft = &draft
+		}
+
+		// Parse labels
+		if labelsVal, ok := request.Params.Arguments["labels"].(string); ok {
+			filter.Labels = splitCommaList(labelsVal)
+		}
+
+		// Parse pagination
+		pagination, paginationErr := parsePaginationOptions(request.Params.Arguments)
+		if paginationErr != nil {
+			return mcp.NewToolResultError(errors.FormatGitHubError(paginationErr)), nil
+		}
+
+		// Call the operation
+		result, err := prOps.ListPullRequests(ctx, owner, repo, filter, pagination)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error listing pull requests: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatPullRequestListToMarkdown(result.Items)
+		markdown += formatTruncationNote(result)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register update_pull_request tool
+	updatePRTool := mcp.NewTool("update_pull_request",
+		mcp.WithDescription("Update the title, body, base branch, state or draft status of a pull request"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Pull request number"),
+		),
+		mcp.WithString("title",
+			mcp.Description("New title"),
+		),
+		mcp.WithString("body",
+			mcp.Description("New body"),
+		),
+		mcp.WithString("base",
+			mcp.Description("New base branch"),
+		),
+		mcp.WithString("state",
+			mcp.Description("New state (open, closed)"),
+		),
+		mcp.WithBoolean("draft",
+			mcp.Description("Convert to draft (true) or mark as ready for review (false)"),
+		),
+		mcp.WithString("expected_updated_at",
+			mcp.Description("The 'Updated' timestamp from when the pull request was last read (ISO 8601); the update is rejected if the pull request changed since"),
+		),
+	)
+
+	s.RegisterTool(updatePRTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		// Only fields that were passed are updated
+		var update github.PullRequestUpdate
+		for _, field := range []struct {
+			name  string
+			value **string
+		}{
+			{"title", &update.Title},
+			{"body", &update.Body},
+			{"base", &update.Base},
+			{"state", &update.State},
+		} {
+			if val, ok := request.Params.Arguments[field.name]; ok {
+				str, ok := val.(string)
+				if !ok {
+					return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError(field.name + " must be a string"))), nil
+				}
+				*field.value = &str
+			}
+		}
+
+		if draftVal, ok := request.Params.Arguments["draft"]; ok {
+			draft, ok := draftVal.(bool)
+			if !ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("draft must be a boolean"))), nil
+			}
+			update.Draft = &draft
+		}
+
+		if expectedVal, ok := request.Params.Arguments["expected_updated_at"].(string); ok && expectedVal != "" {
+			expected, err := time.Parse(time.RFC3339, expectedVal)
+			if err != nil {
+				return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("expected_updated_at must be in ISO 8601 format (YYYY-MM-DDTHH:MM:SSZ)"))), nil
+			}
+			update.ExpectedUpdatedAt = &expected
+		}
+
+		// Call the operation
+		result, err := prOps.UpdatePullRequest(ctx, owner, repo, number, update)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error updating pull request: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatPullRequestToMarkdown(result)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register merge_pull_request tool
+	mergePRTool := mcp.NewTool("merge_pull_request",
+		mcp.WithDescription("Merge a pull request, respecting required checks, reviews and branch protection"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Pull request number"),
+		),
+		mcp.WithString("merge_method",
+			mcp.Description("Merge method (merge, squash, rebase) - default: merge"),
+		),
+		mcp.WithString("commit_title",
+			mcp.Description("Title of the merge or squash commit (default: GitHub's default title)"),
+		),
+		mcp.WithString("commit_message",
+			mcp.Description("Message of the merge or squash commit (default: GitHub's default message)"),
+		),
+		mcp.WithString("expected_head_sha",
+			mcp.Description("SHA the pull request head must match; the merge is rejected if new commits were pushed"),
+		),
+	)
+
+	s.RegisterTool(mergePRTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		// Optional parameters with defaults
+		opts := github.MergePullRequestOptions{
+			MergeMethod: "merge",
+		}
+		if methodVal, ok := request.Params.Arguments["merge_method"].(string); ok && methodVal != "" {
+			opts.MergeMethod = methodVal
+		}
+		if titleVal, ok := request.Params.Arguments["commit_title"].(string); ok {
+			opts.CommitTitle = titleVal
+		}
+		if messageVal, ok := request.Params.Arguments["commit_message"].(string); ok {
+			opts.CommitMessage = messageVal
+		}
+		if shaVal, ok := request.Params.Arguments["expected_head_sha"].(string); ok {
+			opts.ExpectedHeadSHA = shaVal
+		}
+
+		// Call the operation
+		result, err := prOps.MergePullRequest(ctx, owner, repo, number, opts)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error merging pull request: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatPullRequestMergeResultToMarkdown(number, opts.MergeMethod, result)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register update_pull_request_branch tool
+	updateBranchTool := mcp.NewTool("update_pull_request_branch",
+		mcp.WithDescription("Bring a pull request branch up to date by merging the latest changes of its base branch"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Pull request number"),
+		),
+		mcp.WithString("expected_head_sha",
+			mcp.Description("SHA the pull request head must match; the update is rejected if new commits were pushed"),
+		),
+	)
+
+	s.RegisterTool(updateBranchTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		expectedHeadSHA, _ := request.Params.Arguments["expected_head_sha"].(string)
+
+		// Call the operation
+		message, err := prOps.UpdatePullRequestBranch(ctx, owner, repo, number, expectedHeadSHA)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error updating pull request branch: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatPullRequestBranchUpdateToMarkdown(number, message)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register enable_auto_merge tool
+	enableAutoMergeTool := mcp.NewTool("enable_auto_merge",
+		mcp.WithDescription("Enable auto-merge so GitHub merges the pull request once required checks and reviews pass"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Pull request number"),
+		),
+		mcp.WithString("merge_method",
+			mcp.Description("Merge method (merge, squash, rebase) - default: merge"),
+		),
+		mcp.WithString("commit_title",
+			mcp.Description("Title of the merge or squash commit (default: GitHub's default title)"),
+		),
+		mcp.WithString("commit_message",
+			mcp.Description("Message of the merge or squash commit (default: GitHub's default message)"),
+		),
+		mcp.WithString("expected_head_sha",
+			mcp.Description("SHA the pull request head must match; auto-merge is not enabled if new commits were pushed"),
+		),
+	)
+
+	s.RegisterTool(enableAutoMergeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		// Optional parameters with defaults
+		opts := github.AutoMergeOptions{
+			MergeMethod: "merge",
+		}
+		if methodVal, ok := request.Params.Arguments["merge_method"].(string); ok && methodVal != "" {
+			opts.MergeMethod = methodVal
+		}
+		if titleVal, ok := request.Params.Arguments["commit_title"].(string); ok {
+			opts.CommitTitle = titleVal
+		}
+		if messageVal, ok := request.Params.Arguments["commit_message"].(string); ok {
+			opts.CommitMessage = messageVal
+		}
+		if shaVal, ok := request.Params.Arguments["expected_head_sha"].(string); ok {
+			opts.ExpectedHeadSHA = shaVal
+		}
+
+		// Call the operation
+		autoMerge, err := prOps.EnableAutoMerge(ctx, owner, repo, number, opts)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error enabling auto-merge: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatAutoMergeToMarkdown(number, autoMerge)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register disable_auto_merge tool
+	disableAutoMergeTool := mcp.NewTool("disable_auto_merge",
+		mcp.WithDescription("Disable auto-merge of a pull request"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Pull request number"),
+		),
+	)
+
+	s.RegisterTool(disableAutoMergeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		// Call the operation
+		if err := prOps.DisableAutoMerge(ctx, owner, repo, number); err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error disabling auto-merge: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Auto-merge disabled for pull request #%d", number)), nil
+	})
+
 	// Register get_pull_request_diff tool
 	getPRDiffTool := mcp.NewTool("get_pull_request_diff",
 		mcp.WithDescription("Get the diff of a pull request in a GitHub repository"),
@@ -199,4 +676,157 @@ func RegisterPullRequestTools(s *Server) {
 		markdown := formatPullRequestDiffToMarkdown(number, diff)
 		return mcp.NewToolResultText(markdown), nil
 	})
+
+	// Register get_pull_request_files tool
+	getPRFilesTool := mcp.NewTool("get_pull_request_files",
+		mcp.WithDescription("List the files changed by a pull request with status, diff statistics, rename information and per-file patches"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Pull request number"),
+		),
+		mcp.WithString("include",
+			mcp.Description("Comma-separated glob patterns of paths to include, e.g. 'pkg/**/*.go'. Patterns without '/' match the file name in any directory"),
+		),
+		mcp.WithString("exclude",
+			mcp.Description("Comma-separated glob patterns of paths to exclude, e.g. '*_test.go,testdata/**'"),
+		),
+		mcp.WithString("patch",
+			mcp.Description("How to include per-file patches (none, truncated, full) - default: truncated"),
+		),
+		mcp.WithNumber("page",
+			mcp.Description("Fetch only this page (default: fetch pages automatically up to max_items)"),
+		),
+		mcp.WithNumber("per_page",
+			mcp.Description("Number of results per page (max 100, default 30)"),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Maximum number of results to fetch across pages when page is not set (default: 100, max: 1000)"),
+		),
+	)
+
+	s.RegisterTool(getPRFilesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		// Optional parameters with defaults
+		patchMode := "truncated"
+		if patchVal, ok := request.Params.Arguments["patch"].(string); ok && patchVal != "" {
+			patchMode = patchVal
+		}
+		switch patchMode {
+		case "none", "truncated", "full":
+		default:
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("patch must be one of: none, truncated, full"))), nil
+		}
+
+		var paths github.PathFilter
+		if includeVal, ok := request.Params.Arguments["include"].(string); ok {
+			paths.Include = splitCommaList(includeVal)
+		}
+		if excludeVal, ok := request.Params.Arguments["exclude"].(string); ok {
+			paths.Exclude = splitCommaList(excludeVal)
+		}
+
+		// Parse pagination
+		pagination, paginationErr := parsePaginationOptions(request.Params.Arguments)
+		if paginationErr != nil {
+			return mcp.NewToolResultError(errors.FormatGitHubError(paginationErr)), nil
+		}
+
+		// Call the operation
+		result, err := prOps.ListPullRequestFiles(ctx, owner, repo, number, paths, pagination)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting pull request files: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatPullRequestFilesToMarkdown(number, result.Items, patchMode)
+		markdown += formatTruncationNote(result)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register get_pull_request_status tool
+	getPRStatusTool := mcp.NewTool("get_pull_request_status",
+		mcp.WithDescription("Check whether a pull request can be merged and, if not, why: combines mergeability, check runs, commit statuses, reviews and how far the branch is behind its base"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Pull request number"),
+		),
+	)
+
+	s.RegisterTool(getPRStatusTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		// Call the operation
+		status, err := prOps.GetPullRequestStatus(ctx, owner, repo, number)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting pull request status: %v", err)), nil
+		}
+
+		// Format the status as markdown
+		markdown := formatPullRequestStatusToMarkdown(status)
+		return mcp.NewToolResultText(markdown), nil
+	})
+}
+
+// splitCommaList splits a comma-separated parameter into its trimmed, non-empty elements
+func splitCommaList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
 }
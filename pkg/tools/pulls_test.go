@@ -256,6 +256,212 @@ func TestPullRequest(t *testing.T) {
 			},
 		},
 
+		// list_pull_requests - Happy Path
+		{
+			Name: "ListPRs",
+			Tool: "list_pull_requests",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+			},
+		},
+		{
+			Name: "ListPRsByAuthorAndLabel",
+			Tool: "list_pull_requests",
+			Input: map[string]interface{}{
+				"owner":     OWNER,
+				"repo":      REPO,
+				"state":     "all",
+				"author":    "geropl",
+				"labels":    "bug",
+				"max_items": float64(10),
+			},
+		},
+
+		// list_pull_requests - Validation
+		{
+			Name: "ListPRsInvalidState",
+			Tool: "list_pull_requests",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+				"state": "merged",
+			},
+		},
+		{
+			Name: "ListPRsInvalidSort",
+			Tool: "list_pull_requests",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+				"sort":  "comments",
+			},
+		},
+		{
+			Name: "ListPRsInvalidDraft",
+			Tool: "list_pull_requests",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+				"draft": "yes",
+			},
+		},
+
+		// update_pull_request - Happy Path
+		{
+			Name: "UpdatePRTitleAndDraft",
+			Tool: "update_pull_request",
+			Input: map[string]interface{}{
+				"owner":               OWNER,
+				"repo":                REPO,
+				"number":              PR_NUMBER,
+				"title":               "Test PR (updated)",
+				"draft":               true,
+				"expected_updated_at": "2025-03-07T07:45:38Z",
+			},
+		},
+
+		// update_pull_request - Validation
+		{
+			Name: "UpdatePRNoChanges",
+			Tool: "update_pull_request",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": PR_NUMBER,
+			},
+		},
+		{
+			Name: "UpdatePRInvalidState",
+			Tool: "update_pull_request",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": PR_NUMBER,
+				"state":  "merged",
+			},
+		},
+		{
+			Name: "UpdatePRInvalidExpectedUpdatedAt",
+			Tool: "update_pull_request",
+			Input: map[string]interface{}{
+				"owner":               OWNER,
+				"repo":                REPO,
+				"number":              PR_NUMBER,
+				"title":               "New title",
+				"expected_updated_at": "yesterday",
+			},
+		},
+
+		// merge_pull_request - Happy Path
+		{
+			Name: "MergePRSquash",
+			Tool: "merge_pull_request",
+			Input: map[string]interface{}{
+				"owner":             OWNER,
+				"repo":              REPO,
+				"number":            6,
+				"merge_method":      "squash",
+				"commit_title":      "Add greeting (#6)",
+				"expected_head_sha": "e5a4b92d35f6809c1d2e3f4a5b6c7d8e9f0a1b2c",
+			},
+		},
+
+		// merge_pull_request - Validation
+		{
+			Name: "MergePRInvalidMethod",
+			Tool: "merge_pull_request",
+			Input: map[string]interface{}{
+				"owner":        OWNER,
+				"repo":         REPO,
+				"number":       PR_NUMBER,
+				"merge_method": "fast-forward",
+			},
+		},
+		{
+			Name: "MergePRRebaseWithCommitTitle",
+			Tool: "merge_pull_request",
+			Input: map[string]interface{}{
+				"owner":        OWNER,
+				"repo":         REPO,
+				"number":       PR_NUMBER,
+				"merge_method": "rebase",
+				"commit_title": "Custom title",
+			},
+		},
+
+		// update_pull_request_branch, enable_auto_merge and disable_auto_merge - Happy Path
+		{
+			Name: "UpdateBranch",
+			Tool: "update_pull_request_branch",
+			Input: map[string]interface{}{
+				"owner":             OWNER,
+				"repo":              REPO,
+				"number":            PR_NUMBER,
+				"expected_head_sha": "6f4e312c2e1478d5a59fc11d1bb0d14209db21f9",
+			},
+		},
+		{
+			Name: "EnableAutoMergeSquash",
+			Tool: "enable_auto_merge",
+			Input: map[string]interface{}{
+				"owner":        OWNER,
+				"repo":         REPO,
+				"number":       PR_NUMBER,
+				"merge_method": "squash",
+			},
+		},
+		{
+			Name: "DisableAutoMerge",
+			Tool: "disable_auto_merge",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": PR_NUMBER,
+			},
+		},
+
+		// update_pull_request_branch, enable_auto_merge and disable_auto_merge - Validation
+		{
+			Name: "UpdateBranchInvalidNumber",
+			Tool: "update_pull_request_branch",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 0,
+			},
+		},
+		{
+			Name: "EnableAutoMergeInvalidMethod",
+			Tool: "enable_auto_merge",
+			Input: map[string]interface{}{
+				"owner":        OWNER,
+				"repo":         REPO,
+				"number":       PR_NUMBER,
+				"merge_method": "fast-forward",
+			},
+		},
+		{
+			Name: "EnableAutoMergeRebaseWithCommitMessage",
+			Tool: "enable_auto_merge",
+			Input: map[string]interface{}{
+				"owner":          OWNER,
+				"repo":           REPO,
+				"number":         PR_NUMBER,
+				"merge_method":   "rebase",
+				"commit_message": "Custom message",
+			},
+		},
+		{
+			Name: "DisableAutoMergeEmptyRepo",
+			Tool: "disable_auto_merge",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   "",
+				"number": PR_NUMBER,
+			},
+		},
+
 		// get_pull_request_diff - Happy Path
 		{
 			Name: "GetDiffForOpenPR",
@@ -331,6 +537,80 @@ func TestPullRequest(t *testing.T) {
 				"number": -1, // Invalid PR number
 			},
 		},
+
+		// get_pull_request_files - Happy Path
+		{
+			Name: "GetFilesWithInclude",
+			Tool: "get_pull_request_files",
+			Input: map[string]interface{}{
+				"owner":   OWNER,
+				"repo":    REPO,
+				"number":  PR_NUMBER,
+				"include": "*.md",
+				"patch":   "full",
+			},
+		},
+
+		// get_pull_request_files - Validation
+		{
+			Name: "GetFilesInvalidPatchMode",
+			Tool: "get_pull_request_files",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": PR_NUMBER,
+				"patch":  "partial",
+			},
+		},
+		{
+			Name: "GetFilesInvalidGlob",
+			Tool: "get_pull_request_files",
+			Input: map[string]interface{}{
+				"owner":   OWNER,
+				"repo":    REPO,
+				"number":  PR_NUMBER,
+				"include": "pkg/[a-",
+			},
+		},
+		{
+			Name: "GetFilesInvalidNumber",
+			Tool: "get_pull_request_files",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 0,
+			},
+		},
+
+		// get_pull_request_status - Happy Path
+		{
+			Name: "GetStatusBehind",
+			Tool: "get_pull_request_status",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": PR_NUMBER,
+			},
+		},
+
+		// get_pull_request_status - Validation
+		{
+			Name: "GetStatusInvalidNumber",
+			Tool: "get_pull_request_status",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": -1,
+			},
+		},
+		{
+			Name: "GetStatusMissingNumber",
+			Tool: "get_pull_request_status",
+			Input: map[string]interface{}{
+				"owner": OWNER,
+				"repo":  REPO,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,268 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gh "github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+	"github.com/geropl/github-mcp-go/pkg/github"
+)
+
+// RegisterReactionTools registers reaction-related tools
+func RegisterReactionTools(s *Server) {
+	client := s.GetClient()
+	logger := s.GetLogger()
+	reactionOps := github.NewReactionOperations(client, logger)
+
+	subjectDescription := fmt.Sprintf("What to react to: %s (an issue or pull request, by number), %s (a conversation comment, by ID) or %s (an inline review comment, by ID)",
+		github.ReactionSubjectIssue, github.ReactionSubjectIssueComment, github.ReactionSubjectReviewComment)
+	contentDescription := fmt.Sprintf("Reaction (%s)", strings.Join(github.ReactionContents, ", "))
+
+	// Register list_reactions tool
+	listReactionsTool := mcp.NewTool("list_reactions",
+		mcp.WithDescription("List the reactions on an issue, pull request, issue comment or review comment"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithString("subject",
+			mcp.Required(),
+			mcp.Description(subjectDescription),
+		),
+		mcp.WithNumber("id",
+			mcp.Required(),
+			mcp.Description("Issue or pull request number, or comment ID"),
+		),
+		mcp.WithString("content",
+			mcp.Description("Only list reactions of this kind"),
+		),
+		mcp.WithNumber("page",
+			mcp.Description("Fetch only this page (default: fetch pages automatically up to max_items)"),
+		),
+		mcp.WithNumber("per_page",
+			mcp.Description("Number of results per page (max 100, default 30)"),
+		),
+		mcp.WithNumber("max_items",
+			mcp.Description("Maximum number of results to fetch across pages when page is not set (default: 100, max: 1000)"),
+		),
+	)
+
+	s.RegisterTool(listReactionsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		subject, ok := request.Params.Arguments["subject"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("subject must be a string"))), nil
+		}
+
+		idFloat, ok := request.Params.Arguments["id"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("id must be a number"))), nil
+		}
+		id := int64(idFloat)
+
+		content, _ := request.Params.Arguments["content"].(string)
+
+		// Parse pagination
+		pagination, paginationErr := parsePaginationOptions(request.Params.Arguments)
+		if paginationErr != nil {
+			return mcp.NewToolResultError(errors.FormatGitHubError(paginationErr)), nil
+		}
+
+		// Call the operation
+		result, err := reactionOps.ListReactions(ctx, owner, repo, subject, id, content, pagination)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error listing reactions: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatReactionListToMarkdown(subject, id, result.Items)
+		markdown += formatTruncationNote(result)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register add_reaction tool
+	addReactionTool := mcp.NewTool("add_reaction",
+		mcp.WithDescription("Add a reaction to an issue, pull request, issue comment or review comment"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithString("subject",
+			mcp.Required(),
+			mcp.Description(subjectDescription),
+		),
+		mcp.WithNumber("id",
+			mcp.Required(),
+			mcp.Description("Issue or pull request number, or comment ID"),
+		),
+		mcp.WithString("content",
+			mcp.Required(),
+			mcp.Description(contentDescription),
+		),
+	)
+
+	s.RegisterTool(addReactionTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		subject, ok := request.Params.Arguments["subject"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("subject must be a string"))), nil
+		}
+
+		idFloat, ok := request.Params.Arguments["id"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("id must be a number"))), nil
+		}
+		id := int64(idFloat)
+
+		content, ok := request.Params.Arguments["content"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("content must be a string"))), nil
+		}
+
+		// Call the operation
+		reaction, err := reactionOps.AddReaction(ctx, owner, repo, subject, id, content)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error adding reaction: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Added reaction %s (ID %d) to %s %d", reaction.GetContent(), reaction.GetID(), subject, id)), nil
+	})
+
+	// Register remove_reaction tool
+	removeReactionTool := mcp.NewTool("remove_reaction",
+		mcp.WithDescription("Remove a reaction from an issue, pull request, issue comment or review comment, either by reaction ID or by content (removing your own reaction of that kind)"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithString("subject",
+			mcp.Required(),
+			mcp.Description(subjectDescription),
+		),
+		mcp.WithNumber("id",
+			mcp.Required(),
+			mcp.Description("Issue or pull request number, or comment ID"),
+		),
+		mcp.WithNumber("reaction_id",
+			mcp.Description("ID of the reaction to remove"),
+		),
+		mcp.WithString("content",
+			mcp.Description(contentDescription+"; removes your own reaction of this kind when reaction_id is not set"),
+		),
+	)
+
+	s.RegisterTool(removeReactionTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		subject, ok := request.Params.Arguments["subject"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("subject must be a string"))), nil
+		}
+
+		idFloat, ok := request.Params.Arguments["id"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("id must be a number"))), nil
+		}
+		id := int64(idFloat)
+
+		var reactionID int64
+		if reactionIDFloat, ok := request.Params.Arguments["reaction_id"].(float64); ok {
+			reactionID = int64(reactionIDFloat)
+		}
+
+		content, _ := request.Params.Arguments["content"].(string)
+
+		// Call the operation
+		removed, err := reactionOps.RemoveReaction(ctx, owner, repo, subject, id, reactionID, content)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error removing reaction: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Removed reaction %d from %s %d", removed, subject, id)), nil
+	})
+}
+
+// formatReactionListToMarkdown converts a list of reactions to markdown, with a summary per kind
+func formatReactionListToMarkdown(subject string, id int64, reactions []*gh.Reaction) string {
+	md := fmt.Sprintf("# Reactions on %s %d\n\n", subject, id)
+
+	if len(reactions) == 0 {
+		md += "No reactions found.\n"
+		return md
+	}
+
+	counts := make(map[string]int)
+	for _, reaction := range reactions {
+		counts[reaction.GetContent()]++
+	}
+	var summary []string
+	for _, content := range github.ReactionContents {
+		if counts[content] > 0 {
+			summary = append(summary, fmt.Sprintf("%s × %d", content, counts[content]))
+		}
+	}
+	md += fmt.Sprintf("Found %d reactions: %s\n\n", len(reactions), strings.Join(summary, ", "))
+
+	md += "| ID | Reaction | User |\n"
+	md += "|----|----------|------|\n"
+	for _, reaction := range reactions {
+		md += fmt.Sprintf("| %d | %s | %s |\n", reaction.GetID(), reaction.GetContent(), reaction.GetUser().GetLogin())
+	}
+	md += "\n"
+
+	return md
+}
@@ -0,0 +1,90 @@
+package tools
+
+import (
+	"testing"
+)
+
+func TestReactions(t *testing.T) {
+	testCases := []*TestCase{
+		// list_reactions - Happy Path
+		{
+			Name: "ListIssueReactions",
+			Tool: "list_reactions",
+			Input: map[string]interface{}{
+				"owner":   OWNER,
+				"repo":    REPO,
+				"subject": "issue",
+				"id":      1,
+			},
+		},
+
+		// list_reactions - Validation
+		{
+			Name: "ListReactionsInvalidSubject",
+			Tool: "list_reactions",
+			Input: map[string]interface{}{
+				"owner":   OWNER,
+				"repo":    REPO,
+				"subject": "commit",
+				"id":      1,
+			},
+		},
+
+		// add_reaction - Happy Path
+		{
+			Name: "AddReaction",
+			Tool: "add_reaction",
+			Input: map[string]interface{}{
+				"owner":   OWNER,
+				"repo":    REPO,
+				"subject": "issue_comment",
+				"id":      2710335402,
+				"content": "hooray",
+			},
+		},
+
+		// add_reaction - Validation
+		{
+			Name: "AddReactionInvalidContent",
+			Tool: "add_reaction",
+			Input: map[string]interface{}{
+				"owner":   OWNER,
+				"repo":    REPO,
+				"subject": "issue",
+				"id":      1,
+				"content": "thumbsup",
+			},
+		},
+
+		// remove_reaction - Happy Path
+		{
+			Name: "RemoveReactionByContent",
+			Tool: "remove_reaction",
+			Input: map[string]interface{}{
+				"owner":   OWNER,
+				"repo":    REPO,
+				"subject": "issue",
+				"id":      1,
+				"content": "rocket",
+			},
+		},
+
+		// remove_reaction - Validation
+		{
+			Name: "RemoveReactionMissingTarget",
+			Tool: "remove_reaction",
+			Input: map[string]interface{}{
+				"owner":   OWNER,
+				"repo":    REPO,
+				"subject": "issue_comment",
+				"id":      1,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			RunTest(t, tc)
+		})
+	}
+}
@@ -0,0 +1,732 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	gh "github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/geropl/github-mcp-go/pkg/errors"
+	"github.com/geropl/github-mcp-go/pkg/github"
+)
+
+// RegisterReviewTools registers pull request review-related tools
+func RegisterReviewTools(s *Server) {
+	client := s.GetClient()
+	logger := s.GetLogger()
+	reviewOps := github.NewReviewOperations(client, logger)
+
+	// Register create_pull_request_review tool
+	createReviewTool := mcp.NewTool("create_pull_request_review",
+		mcp.WithDescription("Submit a pull request review (approve, request changes or comment) with optional inline comments"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Pull request number"),
+		),
+		mcp.WithString("event",
+			mcp.Required(),
+			mcp.Description("Review action (APPROVE, REQUEST_CHANGES, COMMENT)"),
+		),
+		mcp.WithString("body",
+			mcp.Description("Review summary (required for REQUEST_CHANGES, and for COMMENT without inline comments)"),
+		),
+		mcp.WithString("comments",
+			mcp.Description("JSON array of inline comments, each with path, line and body, and optionally side (LEFT or RIGHT, default RIGHT), "+
+				"start_line and start_side for multi-line comments, and suggestion (replacement text for the commented lines)"),
+		),
+		mcp.WithString("commit_id",
+			mcp.Description("SHA of the commit to review (default: the latest commit of the pull request)"),
+		),
+	)
+
+	s.RegisterTool(createReviewTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		event, ok := request.Params.Arguments["event"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("event must be a string"))), nil
+		}
+		event = strings.ToUpper(event)
+
+		body := ""
+		if bodyVal, ok := request.Params.Arguments["body"].(string); ok {
+			body = bodyVal
+		}
+
+		commitID := ""
+		if commitIDVal, ok := request.Params.Arguments["commit_id"].(string); ok {
+			commitID = commitIDVal
+		}
+
+		// Parse the JSON string
+		var comments []github.ReviewComment
+		if commentsVal, ok := request.Params.Arguments["comments"]; ok {
+			commentsStr, ok := commentsVal.(string)
+			if !ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("comments must be a string containing a JSON array"))), nil
+			}
+			if commentsStr != "" {
+				if err := json.Unmarshal([]byte(commentsStr), &comments); err != nil {
+					return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("comments must be a valid JSON array: " + err.Error()))), nil
+				}
+			}
+		}
+
+		// Call the operation
+		result, err := reviewOps.CreateReview(ctx, owner, repo, number, event, body, commitID, comments)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error creating review: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatReviewToMarkdown(result, len(comments))
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register get_pull_request_reviews tool
+	getReviewsTool := mcp.NewTool("get_pull_request_reviews",
+		mcp.WithDescription("Get the reviews of a pull request and its inline review comments grouped into threads"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Pull request number"),
+		),
+	)
+
+	s.RegisterTool(getReviewsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		// Call the operation
+		result, err := reviewOps.GetReviews(ctx, owner, repo, number)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting reviews: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatReviewsToMarkdown(number, result)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register list_review_threads tool
+	listThreadsTool := mcp.NewTool("list_review_threads",
+		mcp.WithDescription("List the review threads of a pull request with their resolution state; returns unresolved threads by default"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Pull request number"),
+		),
+		mcp.WithBoolean("include_resolved",
+			mcp.Description("Include resolved threads (default: false)"),
+		),
+	)
+
+	s.RegisterTool(listThreadsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		includeResolved := false
+		if includeResolvedVal, ok := request.Params.Arguments["include_resolved"].(bool); ok {
+			includeResolved = includeResolvedVal
+		}
+
+		// Call the operation
+		result, err := reviewOps.ListReviewThreads(ctx, owner, repo, number, includeResolved)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error listing review threads: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatReviewThreadsToMarkdown(number, includeResolved, result)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register reply_to_review_thread tool
+	replyToThreadTool := mcp.NewTool("reply_to_review_thread",
+		mcp.WithDescription("Reply to a pull request review thread"),
+		mcp.WithString("thread_id",
+			mcp.Required(),
+			mcp.Description("Node ID of the review thread (from list_review_threads)"),
+		),
+		mcp.WithString("body",
+			mcp.Required(),
+			mcp.Description("Reply text"),
+		),
+	)
+
+	s.RegisterTool(replyToThreadTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		threadID, ok := request.Params.Arguments["thread_id"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("thread_id must be a string"))), nil
+		}
+
+		body, ok := request.Params.Arguments["body"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("body must be a string"))), nil
+		}
+
+		// Call the operation
+		result, err := reviewOps.ReplyToReviewThread(ctx, threadID, body)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error replying to review thread: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatReviewThreadReplyToMarkdown(threadID, result)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register resolve_review_thread and unresolve_review_thread tools
+	for _, resolved := range []bool{true, false} {
+		name, description := "resolve_review_thread", "Mark a pull request review thread as resolved"
+		if !resolved {
+			name, description = "unresolve_review_thread", "Mark a resolved pull request review thread as unresolved"
+		}
+
+		resolveTool := mcp.NewTool(name,
+			mcp.WithDescription(description),
+			mcp.WithString("thread_id",
+				mcp.Required(),
+				mcp.Description("Node ID of the review thread (from list_review_threads)"),
+			),
+		)
+
+		s.RegisterTool(resolveTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// Extract parameters
+			threadID, ok := request.Params.Arguments["thread_id"].(string)
+			if !ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("thread_id must be a string"))), nil
+			}
+
+			// Call the operation
+			result, err := reviewOps.SetReviewThreadResolved(ctx, threadID, resolved)
+			if err != nil {
+				if ghErr, ok := err.(*errors.GitHubError); ok {
+					return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("Error updating review thread: %v", err)), nil
+			}
+
+			// Format the result as markdown
+			markdown := formatReviewThreadStateToMarkdown(result)
+			return mcp.NewToolResultText(markdown), nil
+		})
+	}
+
+	// Register request_reviewers tool
+	requestReviewersTool := mcp.NewTool("request_reviewers",
+		mcp.WithDescription("Request reviews on a pull request from users and teams, optionally from the code owners of the changed files"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Pull request number"),
+		),
+		mcp.WithString("reviewers",
+			mcp.Description("Comma-separated list of user logins"),
+		),
+		mcp.WithString("team_reviewers",
+			mcp.Description("Comma-separated list of team slugs (without the organization)"),
+		),
+		mcp.WithBoolean("from_codeowners",
+			mcp.Description("Also request the code owners of the changed files according to the base branch's CODEOWNERS file (default: false)"),
+		),
+	)
+
+	s.RegisterTool(requestReviewersTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		var reviewers, teamReviewers []string
+		if reviewersVal, ok := request.Params.Arguments["reviewers"].(string); ok {
+			reviewers = splitCommaList(reviewersVal)
+		}
+		if teamReviewersVal, ok := request.Params.Arguments["team_reviewers"].(string); ok {
+			teamReviewers = splitCommaList(teamReviewersVal)
+		}
+
+		// Add the code owners of the changed files
+		var suggestions *github.ReviewerSuggestions
+		if fromCodeOwners, ok := request.Params.Arguments["from_codeowners"].(bool); ok && fromCodeOwners {
+			var err error
+			suggestions, err = reviewOps.SuggestReviewers(ctx, owner, repo, number)
+			if err != nil {
+				if ghErr, ok := err.(*errors.GitHubError); ok {
+					return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("Error suggesting reviewers: %v", err)), nil
+			}
+			reviewers = appendUnique(reviewers, suggestions.Users()...)
+			teamReviewers = appendUnique(teamReviewers, suggestions.TeamSlugs(owner)...)
+		}
+
+		// Call the operation
+		pr, err := reviewOps.RequestReviewers(ctx, owner, repo, number, reviewers, teamReviewers)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error requesting reviewers: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatRequestedReviewersToMarkdown(number, pr.RequestedReviewers, pr.RequestedTeams)
+		if suggestions != nil {
+			markdown += formatReviewerSuggestionsToMarkdown(suggestions)
+		}
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register remove_reviewers tool
+	removeReviewersTool := mcp.NewTool("remove_reviewers",
+		mcp.WithDescription("Remove review requests from users and teams on a pull request"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Pull request number"),
+		),
+		mcp.WithString("reviewers",
+			mcp.Description("Comma-separated list of user logins"),
+		),
+		mcp.WithString("team_reviewers",
+			mcp.Description("Comma-separated list of team slugs (without the organization)"),
+		),
+	)
+
+	s.RegisterTool(removeReviewersTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		var reviewers, teamReviewers []string
+		if reviewersVal, ok := request.Params.Arguments["reviewers"].(string); ok {
+			reviewers = splitCommaList(reviewersVal)
+		}
+		if teamReviewersVal, ok := request.Params.Arguments["team_reviewers"].(string); ok {
+			teamReviewers = splitCommaList(teamReviewersVal)
+		}
+
+		// Call the operation
+		remaining, err := reviewOps.RemoveReviewers(ctx, owner, repo, number, reviewers, teamReviewers)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error removing reviewers: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatRequestedReviewersToMarkdown(number, remaining.Users, remaining.Teams)
+		return mcp.NewToolResultText(markdown), nil
+	})
+
+	// Register list_requested_reviewers tool
+	listRequestedReviewersTool := mcp.NewTool("list_requested_reviewers",
+		mcp.WithDescription("List the users and teams whose review of a pull request is pending, optionally with reviewers suggested by CODEOWNERS"),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner (username or organization)"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithNumber("number",
+			mcp.Required(),
+			mcp.Description("Pull request number"),
+		),
+		mcp.WithBoolean("suggest_from_codeowners",
+			mcp.Description("Include the code owners of the changed files according to the base branch's CODEOWNERS file (default: false)"),
+		),
+	)
+
+	s.RegisterTool(listRequestedReviewersTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract parameters
+		owner, ok := request.Params.Arguments["owner"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("owner must be a string"))), nil
+		}
+
+		repo, ok := request.Params.Arguments["repo"].(string)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("repo must be a string"))), nil
+		}
+
+		numberFloat, ok := request.Params.Arguments["number"].(float64)
+		if !ok {
+			return mcp.NewToolResultError(errors.FormatGitHubError(errors.NewInvalidArgumentError("number must be a number"))), nil
+		}
+		number := int(numberFloat)
+
+		// Call the operation
+		reviewers, err := reviewOps.ListRequestedReviewers(ctx, owner, repo, number)
+		if err != nil {
+			if ghErr, ok := err.(*errors.GitHubError); ok {
+				return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Error listing requested reviewers: %v", err)), nil
+		}
+
+		// Format the result as markdown
+		markdown := formatRequestedReviewersToMarkdown(number, reviewers.Users, reviewers.Teams)
+
+		if suggest, ok := request.Params.Arguments["suggest_from_codeowners"].(bool); ok && suggest {
+			suggestions, err := reviewOps.SuggestReviewers(ctx, owner, repo, number)
+			if err != nil {
+				if ghErr, ok := err.(*errors.GitHubError); ok {
+					return mcp.NewToolResultError(errors.FormatGitHubError(ghErr)), nil
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("Error suggesting reviewers: %v", err)), nil
+			}
+			markdown += formatReviewerSuggestionsToMarkdown(suggestions)
+		}
+		return mcp.NewToolResultText(markdown), nil
+	})
+}
+
+// appendUnique appends the values that are not in list yet, comparing case-insensitively like GitHub logins
+func appendUnique(list []string, values ...string) []string {
+	for _, value := range values {
+		found := false
+		for _, existing := range list {
+			if strings.EqualFold(existing, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+// formatReviewToMarkdown converts a submitted review to markdown
+func formatReviewToMarkdown(review *gh.PullRequestReview, commentCount int) string {
+	md := fmt.Sprintf("# Review Submitted\n\n")
+
+	md += fmt.Sprintf("**ID:** %d  \n", review.GetID())
+	md += fmt.Sprintf("**State:** %s  \n", review.GetState())
+	md += fmt.Sprintf("**Author:** %s  \n", review.GetUser().GetLogin())
+	md += fmt.Sprintf("**Commit:** %s  \n", review.GetCommitID())
+	md += fmt.Sprintf("**Inline Comments:** %d  \n", commentCount)
+	md += fmt.Sprintf("**URL:** %s  \n\n", review.GetHTMLURL())
+
+	if review.GetBody() != "" {
+		md += fmt.Sprintf("## Body\n\n%s\n", review.GetBody())
+	}
+
+	return md
+}
+
+// formatReviewsToMarkdown converts the reviews and review threads of a pull request to markdown
+func formatReviewsToMarkdown(number int, result *github.PullRequestReviews) string {
+	md := fmt.Sprintf("# Reviews for Pull Request #%d\n\n", number)
+
+	if len(result.Reviews) == 0 {
+		md += "No reviews found.\n\n"
+	} else {
+		md += "## Reviews\n\n"
+		for _, review := range result.Reviews {
+			md += fmt.Sprintf("### %s by %s\n\n", review.GetState(), review.GetUser().GetLogin())
+			md += fmt.Sprintf("**ID:** %d  \n", review.GetID())
+			if !review.GetSubmittedAt().IsZero() {
+				md += fmt.Sprintf("**Submitted:** %s  \n", review.GetSubmittedAt().Format(time.RFC1123))
+			}
+			md += fmt.Sprintf("**Commit:** %s  \n\n", review.GetCommitID())
+			if review.GetBody() != "" {
+				md += fmt.Sprintf("%s\n\n", review.GetBody())
+			}
+		}
+	}
+
+	if len(result.Threads) == 0 {
+		md += "No review comments found.\n"
+	} else {
+		md += fmt.Sprintf("## Review Threads (%d)\n\n", len(result.Threads))
+		for _, thread := range result.Threads {
+			location := fmt.Sprintf("%s:%d", thread.Path, thread.Line)
+			if thread.StartLine > 0 && thread.StartLine != thread.Line {
+				location = fmt.Sprintf("%s:%d-%d", thread.Path, thread.StartLine, thread.Line)
+			}
+			if thread.Side != "" {
+				location += fmt.Sprintf(" (%s)", thread.Side)
+			}
+			if thread.Outdated {
+				location += " [outdated]"
+			}
+			md += fmt.Sprintf("### %s\n\n", location)
+
+			for _, comment := range thread.Comments {
+				md += fmt.Sprintf("**%s** (%s, comment ID %d):  \n", comment.GetUser().GetLogin(),
+					comment.GetCreatedAt().Format(time.RFC1123), comment.GetID())
+				md += fmt.Sprintf("%s\n\n", comment.GetBody())
+			}
+		}
+	}
+
+	if result.Truncated {
+		md += fmt.Sprintf("**Note:** Only the first %d reviews and review comments are shown.\n", github.MaxItemsLimit)
+	}
+
+	return md
+}
+
+// formatReviewThreadLocation renders the file location of a review thread
+func formatReviewThreadLocation(thread *github.GraphQLReviewThread) string {
+	location := fmt.Sprintf("%s:%d", thread.Path, thread.Line)
+	if thread.StartLine > 0 && thread.StartLine != thread.Line {
+		location = fmt.Sprintf("%s:%d-%d", thread.Path, thread.StartLine, thread.Line)
+	}
+	if thread.DiffSide != "" {
+		location += fmt.Sprintf(" (%s)", thread.DiffSide)
+	}
+	if thread.IsOutdated {
+		location += " [outdated]"
+	}
+	return location
+}
+
+// formatReviewThreadsToMarkdown converts the review threads of a pull request to markdown
+func formatReviewThreadsToMarkdown(number int, includeResolved bool, result *github.ReviewThreadList) string {
+	md := fmt.Sprintf("# Review Threads for Pull Request #%d\n\n", number)
+
+	if len(result.Threads) == 0 {
+		if includeResolved {
+			md += "No review threads found.\n"
+		} else {
+			md += fmt.Sprintf("No unresolved review threads found (%d threads in total).\n", result.Total)
+		}
+		return md
+	}
+
+	if includeResolved {
+		md += fmt.Sprintf("Found %d review threads.\n\n", len(result.Threads))
+	} else {
+		md += fmt.Sprintf("Found %d unresolved review threads (%d threads in total).\n\n", len(result.Threads), result.Total)
+	}
+
+	for i, thread := range result.Threads {
+		md += fmt.Sprintf("## %d. %s\n\n", i+1, formatReviewThreadLocation(thread))
+		md += fmt.Sprintf("**Thread ID:** %s  \n", thread.ID)
+		if thread.IsResolved {
+			resolvedBy := ""
+			if thread.ResolvedBy != nil {
+				resolvedBy = " by " + thread.ResolvedBy.Login
+			}
+			md += fmt.Sprintf("**Resolved:** yes%s  \n\n", resolvedBy)
+		} else {
+			md += "**Resolved:** no  \n\n"
+		}
+
+		for _, comment := range thread.Comments.Nodes {
+			md += fmt.Sprintf("**%s** (%s):  \n", comment.Author.Login, comment.CreatedAt.Format(time.RFC1123))
+			md += fmt.Sprintf("%s\n\n", comment.Body)
+		}
+		if hidden := thread.Comments.TotalCount - len(thread.Comments.Nodes); hidden > 0 {
+			md += fmt.Sprintf("... and %d more comments\n\n", hidden)
+		}
+	}
+
+	if result.Truncated {
+		md += "**Note:** The pull request has more review threads than could be fetched.\n"
+	}
+
+	return md
+}
+
+// formatReviewThreadReplyToMarkdown converts a review thread reply to markdown
+func formatReviewThreadReplyToMarkdown(threadID string, comment *github.ReviewThreadComment) string {
+	md := fmt.Sprintf("# Reply Added to Review Thread\n\n")
+
+	md += fmt.Sprintf("**Thread ID:** %s  \n", threadID)
+	md += fmt.Sprintf("**Comment ID:** %d  \n", comment.DatabaseID)
+	md += fmt.Sprintf("**Author:** %s  \n", comment.Author.Login)
+	md += fmt.Sprintf("**URL:** %s  \n\n", comment.URL)
+	md += fmt.Sprintf("%s\n", comment.Body)
+
+	return md
+}
+
+// formatReviewThreadStateToMarkdown converts the resolution state of a review thread to markdown
+func formatReviewThreadStateToMarkdown(thread *github.GraphQLReviewThread) string {
+	title, state := "Unresolved", "unresolved"
+	if thread.IsResolved {
+		title, state = "Resolved", "resolved"
+	}
+
+	md := fmt.Sprintf("# Review Thread %s\n\n", title)
+	md += fmt.Sprintf("**Thread ID:** %s  \n", thread.ID)
+	md += fmt.Sprintf("**Location:** %s  \n", formatReviewThreadLocation(thread))
+	md += fmt.Sprintf("**State:** %s  \n", state)
+
+	return md
+}
+
+// formatRequestedReviewersToMarkdown converts the pending review requests of a pull request to markdown
+func formatRequestedReviewersToMarkdown(number int, users []*gh.User, teams []*gh.Team) string {
+	md := fmt.Sprintf("# Requested Reviewers (#%d)\n\n", number)
+
+	if len(users) == 0 && len(teams) == 0 {
+		md += "No pending review requests.\n"
+		return md
+	}
+
+	if len(users) > 0 {
+		md += "## Users\n\n"
+		for _, user := range users {
+			md += fmt.Sprintf("- %s\n", user.GetLogin())
+		}
+		md += "\n"
+	}
+
+	if len(teams) > 0 {
+		md += "## Teams\n\n"
+		for _, team := range teams {
+			md += fmt.Sprintf("- %s (%s)\n", team.GetSlug(), team.GetName())
+		}
+		md += "\n"
+	}
+
+	return md
+}
+
+// formatReviewerSuggestionsToMarkdown converts the reviewers suggested by CODEOWNERS to markdown
+func formatReviewerSuggestionsToMarkdown(suggestions *github.ReviewerSuggestions) string {
+	md := fmt.Sprintf("## Code Owners (%s)\n\n", suggestions.CodeOwnersPath)
+
+	if len(suggestions.Suggestions) == 0 {
+		md += "No code owners besides the author own the changed files.\n"
+	}
+	for _, suggestion := range suggestions.Suggestions {
+		md += fmt.Sprintf("- %s: %d files (%s)\n", suggestion.Owner, len(suggestion.Files), truncateString(strings.Join(suggestion.Files, ", "), 200))
+	}
+
+	if len(suggestions.UnownedFiles) > 0 {
+		md += fmt.Sprintf("\n%d changed files have no code owner.\n", len(suggestions.UnownedFiles))
+	}
+
+	return md
+}
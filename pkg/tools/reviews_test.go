@@ -0,0 +1,223 @@
+package tools
+
+import (
+	"testing"
+)
+
+func TestReviews(t *testing.T) {
+	testCases := []*TestCase{
+		// create_pull_request_review - Happy Path
+		{
+			Name: "CreateReviewWithInlineComments",
+			Tool: "create_pull_request_review",
+			Input: map[string]interface{}{
+				"owner":    OWNER,
+				"repo":     REPO,
+				"number":   PR_NUMBER,
+				"event":    "COMMENT",
+				"body":     "A few suggestions",
+				"comments": `[{"path": "test-file.md", "line": 1, "body": "Use a level-two heading here", "suggestion": "## Test file"}]`,
+			},
+		},
+
+		// create_pull_request_review - Validation
+		{
+			Name: "CreateReviewInvalidEvent",
+			Tool: "create_pull_request_review",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": PR_NUMBER,
+				"event":  "REJECT",
+			},
+		},
+		{
+			Name: "RequestChangesWithoutBody",
+			Tool: "create_pull_request_review",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": PR_NUMBER,
+				"event":  "REQUEST_CHANGES",
+			},
+		},
+		{
+			Name: "InvalidCommentsJSON",
+			Tool: "create_pull_request_review",
+			Input: map[string]interface{}{
+				"owner":    OWNER,
+				"repo":     REPO,
+				"number":   PR_NUMBER,
+				"event":    "COMMENT",
+				"comments": "[{\"path\": \"README.md\"",
+			},
+		},
+		{
+			Name: "CommentWithoutLine",
+			Tool: "create_pull_request_review",
+			Input: map[string]interface{}{
+				"owner":    OWNER,
+				"repo":     REPO,
+				"number":   PR_NUMBER,
+				"event":    "COMMENT",
+				"comments": `[{"path": "README.md", "body": "Typo"}]`,
+			},
+		},
+
+		// get_pull_request_reviews - Happy Path
+		{
+			Name: "GetReviews",
+			Tool: "get_pull_request_reviews",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": PR_NUMBER,
+			},
+		},
+
+		// get_pull_request_reviews - Validation
+		{
+			Name: "GetReviewsInvalidNumber",
+			Tool: "get_pull_request_reviews",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": -1,
+			},
+		},
+
+		// review thread tools - Happy Path
+		{
+			Name: "ListThreads",
+			Tool: "list_review_threads",
+			Input: map[string]interface{}{
+				"owner":            OWNER,
+				"repo":             REPO,
+				"number":           PR_NUMBER,
+				"include_resolved": true,
+			},
+		},
+		{
+			Name: "ReplyToThread",
+			Tool: "reply_to_review_thread",
+			Input: map[string]interface{}{
+				"thread_id": "PRRT_kwDOOEmhcs5Ac0pR",
+				"body":      "Thanks, resolving",
+			},
+		},
+		{
+			Name: "ResolveThread",
+			Tool: "resolve_review_thread",
+			Input: map[string]interface{}{
+				"thread_id": "PRRT_kwDOOEmhcs5Ac0pR",
+			},
+		},
+		{
+			Name: "UnresolveThread",
+			Tool: "unresolve_review_thread",
+			Input: map[string]interface{}{
+				"thread_id": "PRRT_kwDOOEmhcs5Ac0pR",
+			},
+		},
+
+		// review thread tools - Validation
+		{
+			Name: "ListThreadsInvalidNumber",
+			Tool: "list_review_threads",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 0,
+			},
+		},
+		{
+			Name: "ReplyWithoutBody",
+			Tool: "reply_to_review_thread",
+			Input: map[string]interface{}{
+				"thread_id": "PRRT_kwDONa1b2c4AAAAB",
+				"body":      "",
+			},
+		},
+		{
+			Name: "ResolveWithoutThreadID",
+			Tool: "resolve_review_thread",
+			Input: map[string]interface{}{
+				"thread_id": "",
+			},
+		},
+		{
+			Name: "UnresolveInvalidThreadID",
+			Tool: "unresolve_review_thread",
+			Input: map[string]interface{}{
+				"thread_id": 42,
+			},
+		},
+
+		// reviewer tools - Happy Path
+		{
+			Name: "RequestReviewers",
+			Tool: "request_reviewers",
+			Input: map[string]interface{}{
+				"owner":     OWNER,
+				"repo":      REPO,
+				"number":    1,
+				"reviewers": "octocat",
+			},
+		},
+		{
+			Name: "ListRequestedReviewers",
+			Tool: "list_requested_reviewers",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 1,
+			},
+		},
+		{
+			Name: "RemoveReviewers",
+			Tool: "remove_reviewers",
+			Input: map[string]interface{}{
+				"owner":     OWNER,
+				"repo":      REPO,
+				"number":    1,
+				"reviewers": "octocat",
+			},
+		},
+
+		// reviewer tools - Validation
+		{
+			Name: "RequestReviewersWithoutReviewers",
+			Tool: "request_reviewers",
+			Input: map[string]interface{}{
+				"owner":     OWNER,
+				"repo":      REPO,
+				"number":    1,
+				"reviewers": " , ",
+			},
+		},
+		{
+			Name: "RemoveReviewersWithoutReviewers",
+			Tool: "remove_reviewers",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 1,
+			},
+		},
+		{
+			Name: "ListRequestedReviewersInvalidNumber",
+			Tool: "list_requested_reviewers",
+			Input: map[string]interface{}{
+				"owner":  OWNER,
+				"repo":   REPO,
+				"number": 0,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			RunTest(t, tc)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	ghclient "github.com/geropl/github-mcp-go/pkg/github"
+	"github.com/geropl/github-mcp-go/pkg/redact"
 )
 
 // Server wraps the MCP server and provides additional functionality
@@ -51,7 +52,42 @@ func (s *Server) RegisterTool(tool mcp.Tool, handler func(ctx context.Context, r
 		return
 	}
 
-	s.server.AddTool(tool, handler)
+	s.server.AddTool(tool, redactToolResult(handler))
+}
+
+// redactedNote is appended to text content in which secrets were masked, so that
+// callers do not write the masked text back to GitHub (e.g. when editing a file)
+const redactedNote = "\n\n**Note:** [content redacted] Secrets in this output were replaced with " + redact.Mask +
+	". Do not write this content back to GitHub as-is, or the original values will be lost.\n"
+
+// redactToolResult wraps a tool handler so that secrets are masked in all text content it returns
+func redactToolResult(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := handler(ctx, request)
+		if result == nil {
+			return result, err
+		}
+
+		for i, content := range result.Content {
+			switch c := content.(type) {
+			case mcp.TextContent:
+				c.Text = redactText(c.Text)
+				result.Content[i] = c
+			case *mcp.TextContent:
+				c.Text = redactText(c.Text)
+			}
+		}
+		return result, err
+	}
+}
+
+// redactText masks secrets in text and adds redactedNote if anything was masked
+func redactText(text string) string {
+	redacted := redact.String(text)
+	if redacted == text {
+		return text
+	}
+	return redacted + redactedNote
 }
 
 // Serve starts the server using stdio
@@ -62,8 +98,13 @@ func (s *Server) Serve() error {
 func RegisterTools(s *Server) {
 	RegisterRepositoryTools(s)
 	RegisterPullRequestTools(s)
+	RegisterReviewTools(s)
 	RegisterFileTools(s)
 	RegisterIssueTools(s)
+	RegisterLabelTools(s)
+	RegisterMilestoneTools(s)
+	RegisterReactionTools(s)
+	RegisterBulkTools(s)
 	RegisterCommitTools(s)
 	RegisterBranchTools(s)
 	RegisterSearchTools(s)
@@ -79,23 +120,38 @@ func (s *Server) WriteAccess() bool {
 // These tools do not modify any state and are safe to auto-approve
 func GetReadOnlyToolNames() map[string]bool {
 	return map[string]bool{
-		"search_repositories":   true,
-		"search_code":           true,
-		"search_issues":         true,
-		"search_commits":        true,
-		"get_file_contents":     true,
-		"get_issue":             true,
-		"list_issues":           true,
-		"list_issue_comments":   true,
-		"get_pull_request":      true,
-		"get_pull_request_diff": true,
-		"get_commit":            true,
-		"list_commits":          true,
-		"compare_commits":       true,
-		"get_commit_status":     true,
-		"list_commit_comments":  true,
-		"list_branches":         true,
-		"get_branch":            true,
+		"search_repositories":        true,
+		"search_code":                true,
+		"search_issues":              true,
+		"search_commits":             true,
+		"get_file_contents":          true,
+		"get_repository_tree":        true,
+		"get_issue":                  true,
+		"list_issues":                true,
+		"list_issue_comments":        true,
+		"get_issue_timeline":         true,
+		"get_issue_hierarchy":        true,
+		"list_closing_pull_requests": true,
+		"list_issue_templates":       true,
+		"list_reactions":             true,
+		"list_labels":                true,
+		"list_milestones":            true,
+		"get_milestone":              true,
+		"get_pull_request":           true,
+		"list_pull_requests":         true,
+		"get_pull_request_reviews":   true,
+		"list_review_threads":        true,
+		"list_requested_reviewers":   true,
+		"get_pull_request_diff":      true,
+		"get_pull_request_files":     true,
+		"get_pull_request_status":    true,
+		"get_commit":                 true,
+		"list_commits":               true,
+		"compare_commits":            true,
+		"get_commit_status":          true,
+		"list_commit_comments":       true,
+		"list_branches":              true,
+		"get_branch":                 true,
 		// GitHub Actions tools
 		"list_workflows":             true,
 		"get_workflow":               true,